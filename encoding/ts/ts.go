@@ -17,9 +17,8 @@
 package ts
 
 import (
-	"strings"
-
 	"github.com/goplus/dql/ts"
+	"github.com/qiniu/x/stream"
 )
 
 const (
@@ -29,8 +28,29 @@ const (
 // Object represents a TypeScript File.
 type Object = *ts.File
 
-// New parses TypeScript source code from the given text, returning a TypeScript
-// File object. An optional Config can be provided to customize the parsing behavior.
-func New(text string, conf ...ts.Config) (f Object, err error) {
-	return ts.ParseFile("index.ts", strings.NewReader(text), conf...)
+// New parses TypeScript source code, returning a TypeScript File object. The
+// source can be:
+//   - string, []byte, *bytes.Buffer or io.Reader: the source code.
+//   - ts.FSPath: the named file of a file system (see ts.ParseFS).
+//
+// Other types of sources are rejected with stream.ErrInvalidSource. The file
+// is named "/index.ts", or "/index.tsx" if Config.Jsx is set; use NewFile to
+// name it. An optional Config can be provided to customize the parsing behavior.
+func New(src any, conf ...ts.Config) (f Object, err error) {
+	switch v := src.(type) {
+	case ts.FSPath:
+		return ts.ParseFS(v.FS, v.Name, conf...)
+	case nil:
+		return nil, stream.ErrInvalidSource
+	}
+	return ts.ParseFile("", src, conf...)
+}
+
+// NewFile parses TypeScript source code of the given file, returning a
+// TypeScript File object. The source can be a string, []byte, *bytes.Buffer or
+// io.Reader holding the source code, or nil to read the file. The filename is
+// used to infer the script kind (e.g. TSX for a .tsx file) unless it's set in
+// Config, and to report positions in errors.
+func NewFile(filename string, src any, conf ...ts.Config) (f Object, err error) {
+	return ts.ParseFile(filename, src, conf...)
 }
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goplus/dql/ts"
+	"github.com/qiniu/x/stream"
+)
+
+const viewSrc = "export const View = () => <div className=\"view\"><b>hi</b></div>;\n"
+
+func TestNew(t *testing.T) {
+	const src = "export function add(a: number, b: number) { return a + b; }\n"
+	cases := []struct {
+		name string
+		src  any
+	}{
+		{"string", src},
+		{"bytes", []byte(src)},
+		{"buffer", bytes.NewBufferString(src)},
+		{"reader", strings.NewReader(src)},
+	}
+	for _, c := range cases {
+		f, err := New(c.src)
+		if err != nil {
+			t.Fatalf("New(%s): %v", c.name, err)
+		}
+		if name := f.FileName(); name != "/index.ts" {
+			t.Errorf("New(%s): got file name %q, want /index.ts", c.name, name)
+		}
+		if got := ts.New(&f.SourceFile).AnyKind(ts.KindFunctionDeclaration).Count(); got != 1 {
+			t.Errorf("New(%s): got %d functions, want 1", c.name, got)
+		}
+	}
+	// JSX needs a .tsx file name, or Config.Jsx
+	f, err := New(viewSrc, ts.Config{Jsx: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ts.New(&f.SourceFile).AnyKind(ts.KindJsxElement).Count(); got != 2 {
+		t.Errorf("New with Jsx: got %d JSX elements, want 2", got)
+	}
+}
+
+func TestNewFile(t *testing.T) {
+	f, err := NewFile("view.tsx", strings.NewReader(viewSrc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := f.FileName(); filepath.Base(name) != "view.tsx" {
+		t.Errorf("NewFile: got file name %q, want view.tsx", name)
+	}
+	if got := ts.New(&f.SourceFile).AnyKind(ts.KindJsxElement).Count(); got != 2 {
+		t.Errorf("NewFile: got %d JSX elements, want 2", got)
+	}
+	if diags := f.Diagnostics(); diags != nil {
+		t.Errorf("NewFile: got diagnostics %v", diags)
+	}
+	// the file is read if src is nil
+	name := filepath.Join(t.TempDir(), "view.tsx")
+	if err := os.WriteFile(name, []byte(viewSrc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if f, err = NewFile(name, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := ts.New(&f.SourceFile).JsxElements("b").Count(); got != 1 {
+		t.Errorf("NewFile of a file: got %d JSX elements, want 1", got)
+	}
+	// errors are reported with the file name
+	_, err = NewFile("broken.ts", "function f( {", ts.Config{FailOnError: true})
+	if err == nil || !strings.Contains(err.Error(), "broken.ts:1:") {
+		t.Errorf("NewFile of a broken file: got %v", err)
+	}
+}
+
+func TestNewFS(t *testing.T) {
+	fsys := fstest.MapFS{"src/view.tsx": {Data: []byte(viewSrc)}}
+	f, err := New(ts.FSPath{FS: fsys, Name: "src/view.tsx"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := f.FileName(); name != "/src/view.tsx" {
+		t.Errorf("New(FSPath): got file name %q, want /src/view.tsx", name)
+	}
+	if got := ts.New(&f.SourceFile).JsxElements("div").Count(); got != 1 {
+		t.Errorf("New(FSPath): got %d JSX elements, want 1", got)
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	if _, err := New(nil); err != stream.ErrInvalidSource {
+		t.Errorf("New(nil): got %v, want ErrInvalidSource", err)
+	}
+	if _, err := New(42); err != stream.ErrInvalidSource {
+		t.Errorf("New(42): got %v, want ErrInvalidSource", err)
+	}
+}
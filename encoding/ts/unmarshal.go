@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/dql/ts"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+var (
+	ErrNoLiteral = errors.New("no default-exported or top-level literal")
+)
+
+// -----------------------------------------------------------------------------
+
+// Unmarshal decodes the literal value of the TypeScript source text src into
+// dst, following the rules of encoding/json. It's intended for configuration
+// written as code, such as:
+//
+//	export default { port: 8080, hosts: ["a", "b"] }
+//
+// The value is the expression of the default export (export default or
+// export =), or else of the sole top-level statement, which must be an
+// expression statement or a variable statement declaring a single variable.
+// As a statement starting with "{" is a block, a top-level object literal must
+// be parenthesized.
+//
+// The value may be an object or array literal, a string, a number (optionally
+// signed), true, false, null, or a template literal without substitutions.
+// Type assertions (as const, satisfies T) are ignored. Any other expression,
+// such as a function call, is an error reporting its position.
+func Unmarshal(src string, dst any) error {
+	f, err := ts.ParseFile("", strings.NewReader(src), ts.Config{FailOnError: true})
+	if err != nil {
+		return err
+	}
+	expr := literalOf(f)
+	if expr == nil {
+		return ErrNoLiteral
+	}
+	v, err := literalValue(expr)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
+
+// literalOf returns the expression holding the value of the file, or nil if
+// there is none.
+func literalOf(f *ts.File) *ast.Node {
+	stmts := f.Statements.Nodes
+	for _, stmt := range stmts {
+		if stmt.Kind == ast.KindExportAssignment {
+			return stmt.Expression()
+		}
+	}
+	if len(stmts) != 1 {
+		return nil
+	}
+	switch stmt := stmts[0]; stmt.Kind {
+	case ast.KindExpressionStatement:
+		return stmt.Expression()
+	case ast.KindVariableStatement:
+		decls := stmt.AsVariableStatement().DeclarationList.AsVariableDeclarationList().Declarations.Nodes
+		if len(decls) == 1 {
+			return decls[0].Initializer()
+		}
+	}
+	return nil
+}
+
+// literalValue evaluates a literal expression to a value of encoding/json:
+// map[string]any, []any, string, float64, bool or nil.
+func literalValue(n *ast.Node) (any, error) {
+	switch n.Kind {
+	case ast.KindObjectLiteralExpression:
+		props := n.AsObjectLiteralExpression().Properties.Nodes
+		ret := make(map[string]any, len(props))
+		for _, prop := range props {
+			if prop.Kind != ast.KindPropertyAssignment {
+				return nil, errorAt(prop, "non-literal property")
+			}
+			name := prop.Name()
+			switch name.Kind {
+			case ast.KindIdentifier, ast.KindStringLiteral, ast.KindNumericLiteral,
+				ast.KindNoSubstitutionTemplateLiteral:
+			default:
+				return nil, errorAt(name, "non-literal property name")
+			}
+			v, err := literalValue(prop.Initializer())
+			if err != nil {
+				return nil, err
+			}
+			ret[name.Text()] = v
+		}
+		return ret, nil
+	case ast.KindArrayLiteralExpression:
+		elems := n.AsArrayLiteralExpression().Elements.Nodes
+		ret := make([]any, len(elems))
+		for i, elem := range elems {
+			v, err := literalValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			ret[i] = v
+		}
+		return ret, nil
+	case ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral:
+		return n.Text(), nil
+	case ast.KindNumericLiteral:
+		return numberValue(n)
+	case ast.KindPrefixUnaryExpression:
+		expr := n.AsPrefixUnaryExpression()
+		if expr.Operand.Kind == ast.KindNumericLiteral {
+			switch expr.Operator {
+			case ast.KindPlusToken:
+				return numberValue(expr.Operand)
+			case ast.KindMinusToken:
+				x, err := numberValue(expr.Operand)
+				return -x, err
+			}
+		}
+	case ast.KindTrueKeyword:
+		return true, nil
+	case ast.KindFalseKeyword:
+		return false, nil
+	case ast.KindNullKeyword:
+		return nil, nil
+	case ast.KindParenthesizedExpression, ast.KindAsExpression, ast.KindSatisfiesExpression:
+		return literalValue(n.Expression())
+	}
+	return nil, errorAt(n, "non-literal expression")
+}
+
+// numberValue returns the value of a numeric literal.
+func numberValue(n *ast.Node) (float64, error) {
+	text := strings.ReplaceAll(n.Text(), "_", "")
+	if x, err := strconv.ParseFloat(text, 64); err == nil {
+		return x, nil
+	}
+	if x, err := strconv.ParseInt(text, 0, 64); err == nil { // 0x, 0o, 0b
+		return float64(x), nil
+	}
+	return 0, errorAt(n, "invalid number "+n.Text())
+}
+
+// errorAt returns an error with the given message, prefixed with the position
+// of the node in the form "file:line:col: " if it's known.
+func errorAt(n *ast.Node, msg string) error {
+	line, col, err := ts.Nodes(ts.Node{Value: reflect.ValueOf(n)}).Pos()
+	if err != nil {
+		return errors.New(msg)
+	}
+	f := n
+	for f.Parent != nil {
+		f = f.Parent
+	}
+	return fmt.Errorf("%s:%d:%d: %s", f.AsSourceFile().FileName(), line, col, msg)
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalMap(t *testing.T) {
+	src := `export default {
+  name: "app",
+  port: 8080,
+  "debug-mode": false,
+  ratio: -0.5,
+  hosts: ["a", ` + "`b`" + `],
+  db: { user: "root", pool: { max: 10, idle: null } },
+} as const;
+`
+	var got map[string]any
+	if err := Unmarshal(src, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"name":       "app",
+		"port":       8080.0,
+		"debug-mode": false,
+		"ratio":      -0.5,
+		"hosts":      []any{"a", "b"},
+		"db":         map[string]any{"user": "root", "pool": map[string]any{"max": 10.0, "idle": nil}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmarshal:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+func TestUnmarshalStruct(t *testing.T) {
+	type Server struct {
+		Host  string
+		Ports []int
+	}
+	var got struct {
+		Servers []Server
+		Tags    map[string]string
+	}
+	src := `const config = {
+  servers: [{ host: "a", ports: [80, 0x1bb] }, { host: "b", ports: [] }],
+  tags: { env: "prod" },
+};`
+	if err := Unmarshal(src, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Servers) != 2 || got.Servers[0].Host != "a" || !reflect.DeepEqual(got.Servers[0].Ports, []int{80, 443}) ||
+		got.Servers[1].Host != "b" || got.Tags["env"] != "prod" {
+		t.Errorf("Unmarshal: got %+v", got)
+	}
+	var arr []string
+	if err := Unmarshal(`(["x", "y"])`, &arr); err != nil || !reflect.DeepEqual(arr, []string{"x", "y"}) {
+		t.Errorf("Unmarshal of an array: got %q (%v)", arr, err)
+	}
+}
+
+func TestUnmarshalError(t *testing.T) {
+	cases := []struct {
+		src, err string
+	}{
+		{"export default { port: getPort() };", "/index.ts:1:24: non-literal expression"},
+		{"export default {\n  hosts: [\"a\", `${b}`],\n};", "/index.ts:2:16: non-literal expression"},
+		{"export default { ...base };", "/index.ts:1:18: non-literal property"},
+		{"export default { [key]: 1 };", "/index.ts:1:18: non-literal property name"},
+	}
+	for _, c := range cases {
+		var v any
+		if err := Unmarshal(c.src, &v); err == nil || err.Error() != c.err {
+			t.Errorf("Unmarshal(%q): got %v, want %s", c.src, err, c.err)
+		}
+	}
+	var v any
+	if err := Unmarshal("let a = 1;\nlet b = 2;", &v); err != ErrNoLiteral {
+		t.Errorf("Unmarshal without literal: got %v, want ErrNoLiteral", err)
+	}
+	if err := Unmarshal("export default {", &v); err == nil {
+		t.Error("Unmarshal of invalid source: no error")
+	}
+}
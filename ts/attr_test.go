@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+func TestAttrLiteral(t *testing.T) {
+	doc := ts.From("", `const a = "say \"hi\"\n";
+const b = 0x1F;
+const c = `+"`tpl`"+`;
+const d = true;
+const e = false;
+const f = a;
+const g = 'it\'s';
+`)
+	want := []any{"say \"hi\"\n", "31", "tpl", true, false, "a", "it's"}
+	i := 0
+	for decl := range doc.AnyKind(ts.KindVariableDeclaration).XGo_Enum() {
+		val, err := decl.XGo_Attr__1("initializer")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if val != want[i] {
+			t.Errorf("$initializer of declaration %d: got %#v, want %#v", i, val, want[i])
+		}
+		i++
+	}
+	if i != len(want) {
+		t.Errorf("got %d declarations, want %d", i, len(want))
+	}
+}
+
+func TestAttrPrivateName(t *testing.T) {
+	doc := ts.From("", "class K { #secret = 1; }")
+	prop := doc.AnyKind(ts.KindPropertyDeclaration)
+	if val := prop.XGo_Attr__0("name"); val != "#secret" {
+		t.Errorf("$name: got %#v, want \"#secret\"", val)
+	}
+	if val := prop.XGo_Attr__0("initializer"); val != "1" {
+		t.Errorf("$initializer: got %#v, want \"1\"", val)
+	}
+}
+
+func TestAttrKind(t *testing.T) {
+	f := parseFixture(t, "sample.ts")
+	cases := []struct {
+		ns   ts.NodeSet
+		kind ts.Kind
+		name string
+	}{
+		{ts.New(&f.SourceFile), ts.KindSourceFile, "KindSourceFile"},
+		{descendants(f).Kind(ts.KindImportDeclaration), ts.KindImportDeclaration, "KindImportDeclaration"},
+		{named(f, "Store"), ts.KindClassDeclaration, "KindClassDeclaration"},
+		{descendants(f).Kind(ts.KindGetAccessor), ts.KindGetAccessor, "KindGetAccessor"},
+		{descendants(f).Kind(ts.KindIdentifier), ts.KindIdentifier, "KindIdentifier"},
+		{descendants(f).Kind(ts.KindStringKeyword), ts.KindStringKeyword, "KindStringKeyword"},
+	}
+	for _, c := range cases {
+		if kind, err := c.ns.XGo_Attr__1("kind"); err != nil || kind != c.kind {
+			t.Errorf("$kind: got %v (%v), want %v", kind, err, c.kind)
+		}
+		if name, err := c.ns.XGo_Attr__1("kindName"); err != nil || name != c.name {
+			t.Errorf("$kindName: got %v (%v), want %v", name, err, c.name)
+		}
+	}
+	if _, err := ts.New(&f.SourceFile).XGo_Elem("fileName").XGo_Attr__1("kindName"); err == nil {
+		t.Error("$kindName of a non-syntax node: no error")
+	}
+}
+
+func TestAttrNameForms(t *testing.T) {
+	doc := ts.From("", `import { x } from "./mod";
+class K {
+  plain = 1;
+  "weird-name" = 2;
+  42 = 3;
+  [Symbol.iterator]() {}
+  #secret = 4;
+  static ["computed" + "key"] = 5;
+}
+const o = {
+  plain: 1,
+  "weird-name": 2,
+  42: 3,
+  [Symbol.iterator]: 4,
+  [key]: 5,
+  short,
+  method() {},
+};
+`)
+	cases := []struct {
+		scope ts.Kind
+		kinds []ts.Kind
+		want  []any
+	}{
+		{ts.KindClassDeclaration, []ts.Kind{ts.KindPropertyDeclaration, ts.KindMethodDeclaration}, []any{
+			"plain", "weird-name", "42", "Symbol.iterator", "#secret", `"computed" + "key"`,
+		}},
+		{ts.KindObjectLiteralExpression, []ts.Kind{ts.KindPropertyAssignment, ts.KindShorthandPropertyAssignment, ts.KindMethodDeclaration}, []any{
+			"plain", "weird-name", "42", "Symbol.iterator", "key", "short", "method",
+		}},
+	}
+	for _, c := range cases {
+		var names []any
+		for member := range doc.AnyKind(c.scope).AnyKind(c.kinds...).XGo_Enum() {
+			name, err := member.XGo_Attr__1("name")
+			if err != nil {
+				t.Fatal(err)
+			}
+			names = append(names, name)
+		}
+		if !slices.Equal(names, c.want) {
+			t.Errorf("$name:\ngot  %q\nwant %q", names, c.want)
+		}
+	}
+	if val := doc.AnyKind(ts.KindImportDeclaration).XGo_Attr__0("moduleSpecifier"); val != "./mod" {
+		t.Errorf("$moduleSpecifier: got %#v, want \"./mod\"", val)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"strings"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// isComment reports whether the token kind is a comment.
+func isComment(kind Kind) bool {
+	return kind == ast.KindSingleLineCommentTrivia || kind == ast.KindMultiLineCommentTrivia
+}
+
+// stripComment removes the comment markers (//, /* and */) of a comment, and
+// the leading * of the lines of a block comment. The result is trimmed.
+func stripComment(text string) string {
+	if rest, ok := strings.CutPrefix(text, "//"); ok {
+		return strings.TrimSpace(rest)
+	}
+	text = strings.TrimPrefix(text, "/*")
+	text = strings.TrimPrefix(text, "*") // JSDoc
+	text = strings.TrimSuffix(text, "*/")
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if i > 0 {
+			line = strings.TrimSpace(strings.TrimPrefix(line, "*"))
+		}
+		lines[i] = line
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// LeadingComments returns the comments before the first node in the NodeSet,
+// between its full start and its first token. Like the upstream TypeScript
+// utilities, a comment on the same line as the previous token is a trailing
+// comment of that token and is not included, unless the node starts the file.
+// If strip is true, the comment markers are removed (see File.Comments).
+func (p NodeSet) LeadingComments(strip bool) (ret []string, err error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return
+	}
+	f := sourceFileOf(n)
+	if f == nil {
+		return nil, ErrNoSourceFile
+	}
+	pos := n.Pos()
+	collecting := pos == 0
+	t := tokenizer{f: f, text: f.Text(), pos: pos, trivia: true, yield: func(tok Token) bool {
+		switch {
+		case tok.Kind == ast.KindNewLineTrivia:
+			collecting = true
+		case collecting && isComment(tok.Kind):
+			text := tok.Text
+			if strip {
+				text = stripComment(text)
+			}
+			ret = append(ret, text)
+		}
+		return true
+	}}
+	t.gap(tokenPos(f, n))
+	return
+}
+
+// Comments returns all comments of the file in source order, both single-line
+// (//) and block (/* */) comments, including JSDoc comments and a leading
+// shebang line. If strip is true, the Text of the comments has the comment
+// markers removed: the leading //, the /* and */ of block comments, and the
+// leading * of the lines of JSDoc-style comments, trimmed of whitespace.
+func (f *File) Comments(strip bool) (ret []Token) {
+	for tok := range tokens(&f.SourceFile, true) {
+		if isComment(tok.Kind) {
+			if strip {
+				tok.Text = stripComment(tok.Text)
+			}
+			ret = append(ret, tok)
+		}
+	}
+	return
+}
+
+// -----------------------------------------------------------------------------
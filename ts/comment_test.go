@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+const commentSrc = `// Copyright 2026 The Authors.
+// SPDX-License-Identifier: MIT
+
+/* eslint-disable no-console */
+import { log } from "./log"; // trailing
+
+const x = 1; /* trailing block */
+
+/**
+ * main runs the app.
+ */
+function main() {
+  log(x);
+}
+`
+
+func TestLeadingComments(t *testing.T) {
+	doc := ts.From("", commentSrc)
+	cases := []struct {
+		ns    ts.NodeSet
+		strip bool
+		want  []string
+	}{
+		{doc.AnyKind(ts.KindImportDeclaration), false, []string{
+			"// Copyright 2026 The Authors.", "// SPDX-License-Identifier: MIT", "/* eslint-disable no-console */",
+		}},
+		{doc.AnyKind(ts.KindImportDeclaration), true, []string{
+			"Copyright 2026 The Authors.", "SPDX-License-Identifier: MIT", "eslint-disable no-console",
+		}},
+		{doc.AnyKind(ts.KindVariableStatement), false, nil}, // "// trailing" belongs to the import
+		{doc.AnyKind(ts.KindFunctionDeclaration), false, []string{"/**\n * main runs the app.\n */"}},
+		{doc.AnyKind(ts.KindFunctionDeclaration), true, []string{"main runs the app."}},
+		{doc.AnyKind(ts.KindExpressionStatement), false, nil},
+	}
+	for _, c := range cases {
+		got, err := c.ns.LeadingComments(c.strip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, c.want) {
+			t.Errorf("LeadingComments(%v):\ngot  %q\nwant %q", c.strip, got, c.want)
+		}
+	}
+	if _, err := doc.AnyKind(ts.KindClassDeclaration).LeadingComments(false); err == nil {
+		t.Error("LeadingComments of an empty NodeSet: no error")
+	}
+}
+
+func TestComments(t *testing.T) {
+	f, err := ts.ParseFile("", commentSrc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := f.Comments(true)
+	want := []ts.Token{
+		{ts.KindSingleLineCommentTrivia, "Copyright 2026 The Authors.", 0, 1, 1},
+		{ts.KindSingleLineCommentTrivia, "SPDX-License-Identifier: MIT", 31, 2, 1},
+		{ts.KindMultiLineCommentTrivia, "eslint-disable no-console", 64, 4, 1},
+		{ts.KindSingleLineCommentTrivia, "trailing", 125, 5, 30},
+		{ts.KindMultiLineCommentTrivia, "trailing block", 151, 7, 14},
+		{ts.KindMultiLineCommentTrivia, "main runs the app.", 173, 9, 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Comments:\ngot  %v\nwant %v", got, want)
+	}
+	if raw := f.Comments(false); raw[4].Text != "/* trailing block */" {
+		t.Errorf("Comments(false): got %q, want the comment as written", raw[4].Text)
+	}
+}
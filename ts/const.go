@@ -0,0 +1,280 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+var (
+	ErrNotConst = errors.New("not a constant declaration")
+)
+
+// -----------------------------------------------------------------------------
+
+// NonConst is the value of an enum member that can't be evaluated statically,
+// such as `A = "abc".length`. It holds the source text of the initializer, or
+// "" for a member following such a member without initializer.
+type NonConst string
+
+// constEval evaluates constant expressions the way TypeScript evaluates enum
+// member initializers. Numbers are float64 and strings are string.
+type constEval struct {
+	names map[string]any // enum members evaluated so far
+	enum  string         // name of the enum, for qualified references (E.A)
+}
+
+func (e *constEval) eval(n *ast.Node) (any, bool) {
+	switch n.Kind {
+	case ast.KindNumericLiteral:
+		return parseNumber(n.AsNumericLiteral().Text)
+	case ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral:
+		return n.Text(), true
+	case ast.KindTrueKeyword:
+		return true, true
+	case ast.KindFalseKeyword:
+		return false, true
+	case ast.KindParenthesizedExpression, ast.KindAsExpression, ast.KindSatisfiesExpression:
+		return e.eval(n.Expression())
+	case ast.KindIdentifier:
+		if v, ok := e.names[nameOf(n)]; ok {
+			return v, !isNonConst(v)
+		}
+		if nameOf(n) == "Infinity" {
+			return math.Inf(1), true
+		}
+	case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression:
+		if e.enum != "" && nameOf(n.Expression()) == e.enum {
+			name := n.Name()
+			if n.Kind == ast.KindElementAccessExpression {
+				name = n.AsElementAccessExpression().ArgumentExpression
+			}
+			if v, ok := e.names[nameOf(name)]; ok {
+				return v, !isNonConst(v)
+			}
+		}
+	case ast.KindTemplateExpression:
+		expr := n.AsTemplateExpression()
+		var b strings.Builder
+		b.WriteString(expr.Head.Text())
+		for _, span := range expr.TemplateSpans.Nodes {
+			v, ok := e.eval(span.Expression())
+			if !ok {
+				return nil, false
+			}
+			b.WriteString(toString(v))
+			b.WriteString(span.AsTemplateSpan().Literal.Text())
+		}
+		return b.String(), true
+	case ast.KindPrefixUnaryExpression:
+		expr := n.AsPrefixUnaryExpression()
+		v, ok := e.eval(expr.Operand)
+		x, isNum := v.(float64)
+		if !ok || !isNum {
+			return nil, false
+		}
+		switch expr.Operator {
+		case ast.KindPlusToken:
+			return x, true
+		case ast.KindMinusToken:
+			return -x, true
+		case ast.KindTildeToken:
+			return float64(^toInt32(x)), true
+		}
+	case ast.KindBinaryExpression:
+		expr := n.AsBinaryExpression()
+		l, ok1 := e.eval(expr.Left)
+		r, ok2 := e.eval(expr.Right)
+		if !ok1 || !ok2 {
+			return nil, false
+		}
+		return binaryOp(expr.OperatorToken.Kind, l, r)
+	}
+	return nil, false
+}
+
+// binaryOp evaluates a binary operation on constants.
+func binaryOp(op Kind, l, r any) (any, bool) {
+	if op == ast.KindPlusToken {
+		ls, lok := l.(string)
+		rs, rok := r.(string)
+		if lok || rok {
+			if lok && rok {
+				return ls + rs, true
+			}
+			return toString(l) + toString(r), true
+		}
+	}
+	x, ok1 := l.(float64)
+	y, ok2 := r.(float64)
+	if !ok1 || !ok2 {
+		return nil, false
+	}
+	switch op {
+	case ast.KindPlusToken:
+		return x + y, true
+	case ast.KindMinusToken:
+		return x - y, true
+	case ast.KindAsteriskToken:
+		return x * y, true
+	case ast.KindSlashToken:
+		return x / y, true
+	case ast.KindPercentToken:
+		return math.Mod(x, y), true
+	case ast.KindAsteriskAsteriskToken:
+		return math.Pow(x, y), true
+	case ast.KindBarToken:
+		return float64(toInt32(x) | toInt32(y)), true
+	case ast.KindAmpersandToken:
+		return float64(toInt32(x) & toInt32(y)), true
+	case ast.KindCaretToken:
+		return float64(toInt32(x) ^ toInt32(y)), true
+	case ast.KindLessThanLessThanToken:
+		return float64(toInt32(x) << (uint32(toInt32(y)) & 31)), true
+	case ast.KindGreaterThanGreaterThanToken:
+		return float64(toInt32(x) >> (uint32(toInt32(y)) & 31)), true
+	case ast.KindGreaterThanGreaterThanGreaterThanToken:
+		return float64(uint32(toInt32(x)) >> (uint32(toInt32(y)) & 31)), true
+	}
+	return nil, false
+}
+
+// toInt32 converts a number to a 32-bit integer like ToInt32 in JavaScript.
+func toInt32(x float64) int32 {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return 0
+	}
+	return int32(uint32(int64(math.Mod(math.Trunc(x), 1<<32))))
+}
+
+// toString converts a constant to a string like JavaScript.
+func toString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1e21 {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+// parseNumber parses the text of a numeric literal.
+func parseNumber(text string) (any, bool) {
+	text = strings.ReplaceAll(text, "_", "")
+	if x, err := strconv.ParseFloat(text, 64); err == nil {
+		return x, true
+	}
+	if x, err := strconv.ParseInt(text, 0, 64); err == nil { // 0x, 0o, 0b
+		return float64(x), true
+	}
+	return nil, false
+}
+
+// goValue converts a number to int64 if it's an integer.
+func goValue(v any) any {
+	if x, ok := v.(float64); ok && x == math.Trunc(x) && math.Abs(x) <= 1<<53 {
+		return int64(x)
+	}
+	return v
+}
+
+func isNonConst(v any) bool {
+	_, ok := v.(NonConst)
+	return ok
+}
+
+// -----------------------------------------------------------------------------
+
+// EnumValues returns the values of the members of the first node in the
+// NodeSet, which must be an enum declaration. Members without initializer are
+// auto-incremented from the previous numeric member, starting from 0. Constant
+// initializers are evaluated the way TypeScript does, including references to
+// previous members: numbers are returned as int64 if they are integers or
+// float64 otherwise, and strings as string. Members that can't be evaluated
+// statically have a NonConst value rather than failing the call.
+func (p NodeSet) EnumValues() (map[string]any, error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return nil, err
+	}
+	if n.Kind != ast.KindEnumDeclaration {
+		return nil, errorAt(n, "not an enum declaration")
+	}
+	e := constEval{names: make(map[string]any), enum: nameOf(n.Name())}
+	next := any(0.0)
+	for _, m := range n.Members() {
+		v := next
+		if init := m.Initializer(); init != nil {
+			var ok bool
+			if v, ok = e.eval(init); !ok {
+				v = NonConst(typeTextOf(init))
+			}
+		}
+		e.names[declName(m)] = v
+		if x, ok := v.(float64); ok {
+			next = x + 1
+		} else {
+			next = NonConst("")
+		}
+	}
+	ret := make(map[string]any, len(e.names))
+	for name, v := range e.names {
+		ret[name] = goValue(v)
+	}
+	return ret, nil
+}
+
+// ConstValue returns the value of the first node in the NodeSet, which must be
+// a const variable declaration (or a variable statement declaring a single
+// const) with a constant initializer: a string, number, boolean, template
+// literal or an arithmetic expression of them. Numbers are returned as int64 if
+// they are integers or float64 otherwise. ErrNotConst is returned if the node
+// isn't such a declaration.
+func (p NodeSet) ConstValue() (any, error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return nil, err
+	}
+	if n.Kind == ast.KindVariableStatement {
+		decls := n.AsVariableStatement().DeclarationList.AsVariableDeclarationList().Declarations.Nodes
+		if len(decls) != 1 {
+			return nil, ErrNotConst
+		}
+		n = decls[0]
+	}
+	if n.Kind != ast.KindVariableDeclaration || declKeyword(n.Parent) != "const" || n.Initializer() == nil {
+		return nil, ErrNotConst
+	}
+	e := constEval{}
+	v, ok := e.eval(n.Initializer())
+	if !ok {
+		return nil, ErrNotConst
+	}
+	return goValue(v), nil
+}
+
+// -----------------------------------------------------------------------------
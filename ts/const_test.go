@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+func TestEnumValues(t *testing.T) {
+	f := parseFixture(t, "enums.ts")
+	cases := []struct {
+		name string
+		want map[string]any
+	}{
+		{"Direction", map[string]any{"Up": int64(0), "Down": int64(1), "Left": int64(10), "Right": int64(11)}},
+		{"Flags", map[string]any{"None": int64(0), "Read": int64(1), "Write": int64(2), "ReadWrite": int64(3), "Half": 0.5}},
+		{"Color", map[string]any{"Red": "RED", "Green": "GREEN", "Blue": "BLUE"}},
+		{"Mixed", map[string]any{
+			"A": int64(1), "Len": ts.NonConst(`"abc".length`), "After": ts.NonConst(""), "B": int64(2),
+		}},
+	}
+	for _, c := range cases {
+		vals, err := named(f, c.name).EnumValues()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(vals, c.want) {
+			t.Errorf("EnumValues of %s:\ngot  %#v\nwant %#v", c.name, vals, c.want)
+		}
+	}
+	if _, err := named(f, "port").EnumValues(); err == nil {
+		t.Error("EnumValues of a variable: no error")
+	}
+}
+
+func TestConstValue(t *testing.T) {
+	f := parseFixture(t, "enums.ts")
+	cases := []struct {
+		name string
+		want any
+	}{
+		{"port", int64(8080)},
+		{"greeting", "hello world"},
+		{"enabled", true},
+		{"ratio", -1.5},
+	}
+	for _, c := range cases {
+		v, err := named(f, c.name).ConstValue()
+		if err != nil || v != c.want {
+			t.Errorf("ConstValue of %s: got %#v (%v), want %#v", c.name, v, err, c.want)
+		}
+	}
+	// a variable statement declaring a single const
+	stmt := descendants(f).Kind(ts.KindVariableStatement)
+	if v, err := stmt.ConstValue(); err != nil || v != int64(8080) {
+		t.Errorf("ConstValue of a statement: got %#v (%v), want 8080", v, err)
+	}
+	for _, name := range []string{"now", "mutable", "Direction"} {
+		if _, err := named(f, name).ConstValue(); err != ts.ErrNotConst {
+			t.Errorf("ConstValue of %s: got %v, want ErrNotConst", name, err)
+		}
+	}
+	if _, err := nth(stmt, 6).ConstValue(); err != ts.ErrNotConst {
+		t.Errorf("ConstValue of a statement declaring 2 consts: got %v, want ErrNotConst", err)
+	}
+}
@@ -0,0 +1,288 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// DeclInfo represents a declaration of the public API surface of a file.
+// All type texts are rendered from the source as written.
+type DeclInfo struct {
+	Name       string      // qualified name, e.g. "NS.Foo"; "constructor" for constructors
+	Kind       Kind        // KindInterfaceDeclaration, KindFunctionDeclaration, etc.
+	Modifiers  []string    // e.g. "static", "readonly", "abstract", "const"
+	Optional   bool        // optional members (foo?: T)
+	TypeParams []string    // type parameters, e.g. "T extends object"
+	Heritage   []string    // heritage clauses of classes and interfaces, e.g. "extends Base"
+	Params     []ParamInfo // parameters of functions, methods and signatures
+	Type       string      // return type, declared type or aliased type
+	Value      string      // initializer of enum members and variables
+	Members    []DeclInfo  // members of classes, interfaces and enums
+}
+
+// ParamInfo represents a parameter of a function or method.
+type ParamInfo struct {
+	Name     string
+	Type     string
+	Optional bool // a?: T, or a = value
+	Rest     bool // ...a: T
+}
+
+// Declarations returns the public declarations of the given file: interfaces,
+// type aliases, classes, enums, functions, variables and namespaces.
+//
+// A declaration is public if it's exported, either inline or by an export list
+// or default export of the file (export { Foo }, export default Foo), if it's
+// at the top level of a file that isn't a module (a global script), or if it's
+// in an ambient namespace (`declare namespace`, or any namespace of a
+// declaration file). Namespaces are traversed recursively, and the names of
+// their members are qualified, e.g. "NS.Foo". Each overload of a function is
+// returned as a separate entry. Private members of classes are omitted.
+func Declarations(f *File) ([]DeclInfo, error) {
+	var d declCollector
+	isModule := f.ExternalModuleIndicator != nil
+	if isModule && !f.IsDeclarationFile {
+		d.exported = exportedLocals(&f.SourceFile)
+	}
+	d.collect(f.Statements.Nodes, "", !isModule, f.IsDeclarationFile)
+	return d.ret, nil
+}
+
+type declCollector struct {
+	ret      []DeclInfo
+	exported map[string]bool // top-level names exported by export lists
+}
+
+// exportedByName reports whether the declaration named name in the namespace
+// prefix is a top-level declaration exported by an export list.
+func (d *declCollector) exportedByName(prefix, name string) bool {
+	return prefix == "" && d.exported[name]
+}
+
+func (d *declCollector) collect(stmts []*ast.Node, prefix string, all, ambient bool) {
+	for _, stmt := range stmts {
+		public := all || ambient || hasModifier(stmt, ast.KindExportKeyword)
+		switch stmt.Kind {
+		case ast.KindInterfaceDeclaration, ast.KindClassDeclaration, ast.KindEnumDeclaration,
+			ast.KindTypeAliasDeclaration, ast.KindFunctionDeclaration:
+			info := declOf(stmt)
+			if !public && !d.exportedByName(prefix, info.Name) {
+				continue
+			}
+			info.Name = prefix + info.Name
+			d.ret = append(d.ret, info)
+		case ast.KindVariableStatement:
+			list := stmt.AsVariableStatement().DeclarationList
+			mods := append(modifiersOf(stmt), declKeyword(list))
+			for _, decl := range list.AsVariableDeclarationList().Declarations.Nodes {
+				info := declOf(decl)
+				if !public && !d.exportedByName(prefix, info.Name) {
+					continue
+				}
+				info.Name = prefix + info.Name
+				info.Modifiers = slices.Clone(mods)
+				d.ret = append(d.ret, info)
+			}
+		case ast.KindModuleDeclaration:
+			if !public && !d.exportedByName(prefix, declName(stmt)) {
+				continue
+			}
+			info := DeclInfo{Kind: stmt.Kind, Modifiers: modifiersOf(stmt)}
+			inner := hasModifier(stmt, ast.KindDeclareKeyword) || ambient
+			body := stmt
+			for {
+				info.Name += declName(body) // namespace A.B.C {}
+				body = body.Body()
+				if body == nil || body.Kind != ast.KindModuleDeclaration {
+					break
+				}
+				info.Name += "."
+			}
+			info.Name = prefix + info.Name
+			d.ret = append(d.ret, info)
+			if body != nil {
+				d.collect(body.Statements(), info.Name+".", false, inner)
+			}
+		}
+	}
+}
+
+// declOf returns the declaration info of a declaration or class/interface member.
+func declOf(n *ast.Node) DeclInfo {
+	info := DeclInfo{
+		Name:       declName(n),
+		Kind:       n.Kind,
+		Modifiers:  modifiersOf(n),
+		Optional:   n.QuestionToken() != nil,
+		TypeParams: textsOf(typeParametersOf(n)),
+		Type:       typeTextOf(n.Type()),
+	}
+	switch n.Kind {
+	case ast.KindClassDeclaration:
+		info.Heritage = textsOf(listNodes(n.ClassLikeData().HeritageClauses))
+	case ast.KindInterfaceDeclaration:
+		info.Heritage = textsOf(listNodes(n.AsInterfaceDeclaration().HeritageClauses))
+	case ast.KindVariableDeclaration:
+		info.Value = typeTextOf(n.Initializer())
+	case ast.KindEnumMember:
+		info.Value = typeTextOf(n.AsEnumMember().Initializer)
+	}
+	if n.FunctionLikeData() != nil {
+		info.Params = paramsOf(n)
+	}
+	switch n.Kind {
+	case ast.KindClassDeclaration, ast.KindInterfaceDeclaration, ast.KindEnumDeclaration:
+		for _, m := range n.Members() {
+			if m.Kind == ast.KindSemicolonClassElement || m.Kind == ast.KindClassStaticBlockDeclaration ||
+				hasModifier(m, ast.KindPrivateKeyword) || isPrivateName(m.Name()) {
+				continue
+			}
+			info.Members = append(info.Members, declOf(m))
+		}
+	}
+	return info
+}
+
+// declName returns the name of a declaration as written: identifiers and
+// literals unquoted, computed names with brackets, e.g. "[Symbol.iterator]",
+// except for literals (["foo"] is named "foo"). Signatures without names (call,
+// construct and index signatures) return "".
+func declName(n *ast.Node) string {
+	if n.Kind == ast.KindConstructor {
+		return "constructor"
+	}
+	name := n.Name()
+	if name == nil {
+		return ""
+	}
+	if name.Kind == ast.KindComputedPropertyName {
+		if s := nameOf(name.Expression()); s != "" && name.Expression().Kind != ast.KindIdentifier {
+			return s
+		}
+	} else if s := nameOf(name); s != "" {
+		return s
+	}
+	return typeTextOf(name)
+}
+
+// isPrivateName reports whether name is a #private name.
+func isPrivateName(name *ast.Node) bool {
+	return name != nil && name.Kind == ast.KindPrivateIdentifier
+}
+
+// paramsOf returns the parameters of a function-like node.
+func paramsOf(n *ast.Node) []ParamInfo {
+	params := n.Parameters()
+	ret := make([]ParamInfo, len(params))
+	for i, param := range params {
+		decl := param.AsParameterDeclaration()
+		ret[i] = ParamInfo{
+			Name:     typeTextOf(param.Name()),
+			Type:     typeTextOf(decl.Type),
+			Optional: decl.QuestionToken != nil || decl.Initializer != nil,
+			Rest:     decl.DotDotDotToken != nil,
+		}
+	}
+	return ret
+}
+
+// typeParametersOf returns the type parameters of a declaration, if any.
+func typeParametersOf(n *ast.Node) []*ast.Node {
+	switch n.Kind {
+	case ast.KindClassDeclaration, ast.KindInterfaceDeclaration, ast.KindTypeAliasDeclaration:
+	default:
+		if n.FunctionLikeData() == nil {
+			return nil
+		}
+	}
+	return n.TypeParameters()
+}
+
+// modifiersOf returns the modifier keywords of the node, except export,
+// default and declare which are implied by the context.
+func modifiersOf(n *ast.Node) (ret []string) {
+	for _, m := range n.ModifierNodes() {
+		switch m.Kind {
+		case ast.KindExportKeyword, ast.KindDefaultKeyword, ast.KindDeclareKeyword, ast.KindDecorator:
+		default:
+			ret = append(ret, TokenToString(m.Kind))
+		}
+	}
+	return
+}
+
+// Flags of variable declaration lists, as NodeFlagsLet, NodeFlagsConst, etc.
+// of the TypeScript compiler, which the ast package doesn't export.
+const (
+	nodeFlagsLet         = 1 << 0
+	nodeFlagsConst       = 1 << 1
+	nodeFlagsUsing       = 1 << 2
+	nodeFlagsAwaitUsing  = nodeFlagsConst | nodeFlagsUsing
+	nodeFlagsBlockScoped = nodeFlagsLet | nodeFlagsConst | nodeFlagsUsing
+)
+
+// declKeyword returns the keyword of a variable declaration list: "var",
+// "let", "const", "using" or "await using".
+func declKeyword(list *ast.Node) string {
+	switch list.Flags & nodeFlagsBlockScoped {
+	case nodeFlagsLet:
+		return "let"
+	case nodeFlagsConst:
+		return "const"
+	case nodeFlagsUsing:
+		return "using"
+	case nodeFlagsAwaitUsing:
+		return "await using"
+	}
+	return "var"
+}
+
+// typeTextOf returns the source text of the node, or "" if n is nil.
+func typeTextOf(n *ast.Node) string {
+	if n == nil {
+		return ""
+	}
+	text, _ := nodeText(n, false)
+	return text
+}
+
+// textsOf returns the source texts of the nodes.
+func textsOf(nodes []*ast.Node) []string {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ret := make([]string, len(nodes))
+	for i, n := range nodes {
+		ret[i] = typeTextOf(n)
+	}
+	return ret
+}
+
+// listNodes returns the nodes of the list, or nil if the list is nil.
+func listNodes(list *ast.NodeList) []*ast.Node {
+	if list == nil {
+		return nil
+	}
+	return list.Nodes
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+func TestDeclarations(t *testing.T) {
+	decls, err := ts.Declarations(parseFixture(t, "api.d.ts"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ts.DeclInfo{
+		{
+			Name: "Box", Kind: ts.KindInterfaceDeclaration,
+			TypeParams: []string{"T extends object = {}"}, Heritage: []string{"extends Iterable<T>"},
+			Members: []ts.DeclInfo{
+				{Name: "value", Kind: ts.KindPropertySignature, Modifiers: []string{"readonly"}, Type: "T"},
+				{Name: "label", Kind: ts.KindPropertySignature, Optional: true, Type: "string"},
+				{
+					Name: "map", Kind: ts.KindMethodSignature, TypeParams: []string{"U extends object"},
+					Params: []ts.ParamInfo{{Name: "fn", Type: "(v: T) => U"}}, Type: "Box<U>",
+				},
+			},
+		},
+		{
+			Name: "parse", Kind: ts.KindFunctionDeclaration,
+			Params: []ts.ParamInfo{{Name: "text", Type: "string"}}, Type: "number",
+		},
+		{
+			Name: "parse", Kind: ts.KindFunctionDeclaration,
+			Params: []ts.ParamInfo{
+				{Name: "text", Type: "string"},
+				{Name: "radix", Type: "number", Optional: true},
+				{Name: "rest", Type: "any[]", Rest: true},
+			},
+			Type: "number",
+		},
+		{Name: "Id", Kind: ts.KindTypeAliasDeclaration, Type: "string | number"},
+		{
+			Name: "Color", Kind: ts.KindEnumDeclaration, Modifiers: []string{"const"},
+			Members: []ts.DeclInfo{
+				{Name: "Red", Kind: ts.KindEnumMember},
+				{Name: "Green", Kind: ts.KindEnumMember, Value: "2"},
+			},
+		},
+		{
+			Name: "Shape", Kind: ts.KindClassDeclaration,
+			Members: []ts.DeclInfo{
+				{Name: "constructor", Kind: ts.KindConstructor, Params: []ts.ParamInfo{{Name: "name", Type: "string"}}},
+				{Name: "create", Kind: ts.KindMethodDeclaration, Modifiers: []string{"static"}, Params: []ts.ParamInfo{}, Type: "Shape"},
+				{Name: "area", Kind: ts.KindGetAccessor, Params: []ts.ParamInfo{}, Type: "number"},
+			},
+		},
+		{Name: "Geo.Units", Kind: ts.KindModuleDeclaration},
+		{Name: "Geo.Units.meter", Kind: ts.KindVariableDeclaration, Modifiers: []string{"const"}, Type: "number"},
+		{Name: "Geo.Units.Inner", Kind: ts.KindModuleDeclaration},
+		{
+			Name: "Geo.Units.Inner.convert", Kind: ts.KindFunctionDeclaration,
+			Params: []ts.ParamInfo{{Name: "x", Type: "number"}}, Type: "number",
+		},
+	}
+	if len(decls) != len(want) {
+		t.Fatalf("got %d declarations, want %d: %+v", len(decls), len(want), decls)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(decls[i], want[i]) {
+			t.Errorf("declaration %d:\ngot  %+v\nwant %+v", i, decls[i], want[i])
+		}
+	}
+}
+
+func TestDeclarationsExportList(t *testing.T) {
+	f, err := ts.ParseFile("", `class Foo { bar(): void {} }
+function g(x: number) {}
+function internal() {}
+const a = 1, b = 2;
+namespace NS { export const c = 3; }
+export { Foo, g, b as B, NS };
+export default internal;
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decls, err := ts.Declarations(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, decl := range decls {
+		got = append(got, decl.Name)
+	}
+	if want := []string{"Foo", "g", "internal", "b", "NS", "NS.c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Declarations: got %q, want %q", got, want)
+	}
+}
+
+func TestDeclarationsKeyword(t *testing.T) {
+	f, err := ts.ParseFile("", `export var v = 0;
+export let a = 1, b = 2;
+export const c = 3;
+export using r = open();
+export await using ar = openAsync();
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decls, err := ts.Declarations(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][]string
+	for _, decl := range decls {
+		got = append(got, decl.Modifiers)
+	}
+	want := [][]string{
+		{"var"}, {"let"}, {"let"}, {"const"}, {"using"}, {"await using"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Modifiers: got %q, want %q", got, want)
+	}
+	// the declarations of a statement don't share their modifiers
+	decls[1].Modifiers[0] = "changed"
+	if mods := decls[2].Modifiers; !reflect.DeepEqual(mods, []string{"let"}) {
+		t.Errorf("Modifiers of b after changing a: got %q", mods)
+	}
+}
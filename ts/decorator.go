@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"github.com/goplus/xgo/dql"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// decoratorOf returns the first decorator of the node named name, that is,
+// @name or @name(...). Qualified names are matched as written, e.g. "ng.Input".
+func decoratorOf(n *ast.Node, name string) *ast.Node {
+	for _, m := range n.ModifierNodes() {
+		if m.Kind != ast.KindDecorator {
+			continue
+		}
+		expr := m.Expression()
+		if expr.Kind == ast.KindCallExpression {
+			expr = expr.Expression()
+		}
+		if text, err := nodeText(expr, false); err == nil && text == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// Decorated returns a NodeSet containing the declarations (classes, methods,
+// properties, accessors and parameters) among the nodes in the NodeSet and
+// their descendants that carry a decorator named name, either bare (@name) or
+// called (@name(...)). Qualified names are matched as written, e.g. "ng.Input".
+func (p NodeSet) Decorated(name string) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			n := astNode(node)
+			if n == nil {
+				return true
+			}
+			return yieldDescendants(n, func(n *ast.Node) bool {
+				if decoratorOf(n, name) != nil {
+					return yield(syntaxNode(n))
+				}
+				return true
+			})
+		})
+	})
+}
+
+// DecoratorArgs returns the source text of the arguments of the first decorator
+// named name of the first node in the NodeSet, e.g. `{ selector: "app" }` for
+// @Component({ selector: "app" }). It returns "" for a bare decorator, and
+// ErrNotFound if the node has no such decorator.
+func (p NodeSet) DecoratorArgs(name string) (string, error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return "", err
+	}
+	d := decoratorOf(n, name)
+	if d == nil {
+		return "", dql.ErrNotFound
+	}
+	call := d.Expression()
+	if call.Kind != ast.KindCallExpression {
+		return "", nil
+	}
+	args := call.Arguments()
+	if len(args) == 0 {
+		return "", nil
+	}
+	f := sourceFileOf(d)
+	return f.Text()[tokenPos(f, args[0]):args[len(args)-1].End()], nil
+}
+
+// -----------------------------------------------------------------------------
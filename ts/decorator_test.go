@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+	"github.com/goplus/xgo/dql"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// kindsOf returns the syntax kinds of the nodes in the NodeSet.
+func kindsOf(ns ts.NodeSet) (ret []ts.Kind) {
+	ns.Data(func(node ts.Node) bool {
+		ret = append(ret, node.Value.Interface().(*ast.Node).Kind)
+		return true
+	})
+	return
+}
+
+func TestDecorated(t *testing.T) {
+	doc := ts.New(&parseFixture(t, "decorators.ts").SourceFile)
+	cases := []struct {
+		name  string
+		kinds []ts.Kind
+	}{
+		{"Component", []ts.Kind{ts.KindClassDeclaration}},
+		{"Injectable", []ts.Kind{ts.KindClassDeclaration}},
+		{"Input", []ts.Kind{ts.KindPropertyDeclaration, ts.KindPropertyDeclaration}},
+		{"HostListener", []ts.Kind{ts.KindMethodDeclaration}},
+		{"Inject", []ts.Kind{ts.KindParameter}},
+		{"ng.Output", []ts.Kind{ts.KindPropertyDeclaration}},
+		{"Output", nil},
+		{"Deprecated", nil},
+	}
+	for _, c := range cases {
+		if kinds := kindsOf(doc.Decorated(c.name)); !slices.Equal(kinds, c.kinds) {
+			t.Errorf("Decorated(%q): got %v, want %v", c.name, kinds, c.kinds)
+		}
+	}
+}
+
+func TestDecoratorArgs(t *testing.T) {
+	doc := ts.New(&parseFixture(t, "decorators.ts").SourceFile)
+	cases := []struct {
+		name, want string
+	}{
+		{"Component", `{ selector: "app-root", standalone: true }`},
+		{"HostListener", `"click", ["$event"]`},
+		{"Inject", "TOKEN"},
+		{"Input", ""},      // called without arguments
+		{"Injectable", ""}, // bare
+	}
+	for _, c := range cases {
+		args, err := doc.Decorated(c.name).DecoratorArgs(c.name)
+		if err != nil || args != c.want {
+			t.Errorf("DecoratorArgs(%q): got %q (%v), want %q", c.name, args, err, c.want)
+		}
+	}
+	cls := doc.AnyKind(ts.KindClassDeclaration)
+	if _, err := cls.DecoratorArgs("Injectable"); err != dql.ErrNotFound {
+		t.Errorf("DecoratorArgs of an undecorated node: got %v, want ErrNotFound", err)
+	}
+}
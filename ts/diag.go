@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// Diagnostic represents a syntax diagnostic reported by the parser.
+type Diagnostic struct {
+	File     string // file name
+	Message  string
+	Category string // "error", "warning", "suggestion" or "message"
+	Code     int    // TypeScript diagnostic code, e.g. 1005 for "'{0}' expected."
+	Start    int    // byte offset
+	Length   int    // length in bytes
+	Line     int    // 1-based line of Start
+	Column   int    // 1-based column of Start, counted in runes
+}
+
+// Error returns the diagnostic in the form "file:line:col: message".
+func (d Diagnostic) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", d.File, d.Line, d.Column, d.Message)
+}
+
+// diagnosticsOf returns the syntax diagnostics of the given file.
+func diagnosticsOf(f *ast.SourceFile) []Diagnostic {
+	diags := f.Diagnostics()
+	if len(diags) == 0 {
+		return nil
+	}
+	ret := make([]Diagnostic, len(diags))
+	for i, d := range diags {
+		line, col := lineAndColumn(f, d.Pos())
+		ret[i] = Diagnostic{
+			File:     f.FileName(),
+			Message:  d.String(),
+			Category: d.Category().Name(),
+			Code:     int(d.Code()),
+			Start:    d.Pos(),
+			Length:   d.Len(),
+			Line:     line,
+			Column:   col,
+		}
+	}
+	return ret
+}
+
+// syntaxError returns the error diagnostics of the given file joined together,
+// or nil if there is none.
+func syntaxError(f *ast.SourceFile) error {
+	var errs []error
+	for _, d := range diagnosticsOf(f) {
+		if d.Category == "error" {
+			errs = append(errs, d)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Diagnostics returns the syntax diagnostics reported while parsing the file.
+// The parser recovers from syntax errors, so a file with errors still has a
+// syntax tree; set Config.FailOnError to make parsing fail instead.
+func (f *File) Diagnostics() []Diagnostic {
+	return diagnosticsOf(&f.SourceFile)
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+const brokenSrc = `function f(a: number) {
+  if (a > 0) {
+    return a;
+}
+`
+
+func TestDiagnostics(t *testing.T) {
+	f, err := ts.ParseFile("broken.ts", brokenSrc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diags := f.Diagnostics()
+	if len(diags) != 1 {
+		t.Fatalf("Diagnostics: got %v, want 1 diagnostic", diags)
+	}
+	file, _ := filepath.Abs("broken.ts")
+	d := diags[0]
+	if d.File != file || d.Category != "error" || d.Code != 1005 || d.Message != "'}' expected." {
+		t.Errorf("Diagnostic: got %+v", d)
+	}
+	if d.Start != len(brokenSrc) || d.Line != 5 || d.Column != 1 {
+		t.Errorf("Diagnostic position: got %d (%d:%d), want %d (5:1)", d.Start, d.Line, d.Column, len(brokenSrc))
+	}
+	if want := file + ":5:1: '}' expected."; d.Error() != want {
+		t.Errorf("Error: got %q, want %q", d.Error(), want)
+	}
+	// the tree is still built
+	if got := count(descendants(f).Kind(ts.KindReturnStatement)); got != 1 {
+		t.Errorf("return statements: got %d, want 1", got)
+	}
+
+	ok, err := ts.ParseFile("ok.ts", brokenSrc+"}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := ok.Diagnostics(); diags != nil {
+		t.Errorf("Diagnostics of a valid file: got %v, want none", diags)
+	}
+}
+
+func TestFailOnError(t *testing.T) {
+	strict := ts.Config{FailOnError: true}
+	_, err := ts.ParseFile("broken.ts", brokenSrc, strict)
+	var d ts.Diagnostic
+	if !errors.As(err, &d) || d.Code != 1005 {
+		t.Fatalf("ParseFile: got %v, want a diagnostic error", err)
+	}
+	if ns := ts.From("broken.ts", brokenSrc, strict); ns.Err == nil {
+		t.Error("From: no error")
+	}
+	if _, err := ts.ParseFile("ok.ts", brokenSrc+"}\n", strict); err != nil {
+		t.Errorf("ParseFile of a valid file: %v", err)
+	}
+}
@@ -0,0 +1,216 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/goplus/xgo/dql/reflects"
+)
+
+// -----------------------------------------------------------------------------
+
+// isSourceFile reports whether the file name has a TypeScript extension
+// (.ts, .tsx, .mts, .cts, including declaration files like .d.ts).
+func isSourceFile(name string) bool {
+	switch filepath.Ext(name) {
+	case ".ts", ".tsx", ".mts", ".cts":
+		return true
+	}
+	return false
+}
+
+// parseFiles parses the given files in parallel. The returned files keep the
+// order of names, failed files are left nil.
+func parseFiles(names []string, conf ...Config) ([]*File, error) {
+	files := make([]*File, len(names))
+	errs := make([]error, len(names))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(names)) {
+		wg.Go(func() {
+			for i := range jobs {
+				files[i], errs[i] = ParseFile(names[i], nil, conf...)
+			}
+		})
+	}
+	for i := range names {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return files, errors.Join(errs...)
+}
+
+// compactFiles removes the nil entries (failed files) of files and names.
+func compactFiles(files []*File, names []string) ([]*File, []string) {
+	n := 0
+	for i, f := range files {
+		if f != nil {
+			files[n], names[n] = f, names[i]
+			n++
+		}
+	}
+	return files[:n], names[:n]
+}
+
+// excluded reports whether the path (slash-separated, relative to the walked
+// directory) matches any of the Exclude patterns.
+func excluded(rel string, exclude []string) bool {
+	for _, pattern := range exclude {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// walkDir returns the TypeScript files under dir, skipping node_modules, hidden
+// directories and the excluded files and directories.
+func walkDir(dir string, exclude []string) (names []string, err error) {
+	var errs []error
+	err = filepath.WalkDir(dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		if name == dir {
+			return nil
+		}
+		base := d.Name()
+		if rel, e := filepath.Rel(dir, name); e == nil && excluded(filepath.ToSlash(rel), exclude) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if base == "node_modules" || strings.HasPrefix(base, ".") {
+				return filepath.SkipDir
+			}
+		} else if isSourceFile(base) {
+			names = append(names, name)
+		}
+		return nil
+	})
+	if err == nil {
+		err = errors.Join(errs...)
+	}
+	return
+}
+
+// ParseDir parses all TypeScript files (.ts, .tsx, .mts, .cts, including
+// declaration files) under dir recursively, skipping node_modules, hidden
+// directories and the files and directories excluded by Config.Exclude. Files
+// are parsed in parallel.
+// It returns the successfully parsed files in lexical order of their paths,
+// along with the errors of the failed files joined together.
+func ParseDir(dir string, conf ...Config) ([]*File, error) {
+	names, err := walkDir(dir, excludeOf(conf))
+	files, err2 := parseFiles(names, conf...)
+	files, _ = compactFiles(files, names)
+	return files, errors.Join(err, err2)
+}
+
+// excludeOf returns the Exclude patterns of the optional Config.
+func excludeOf(conf []Config) []string {
+	if len(conf) > 0 {
+		return conf[0].Exclude
+	}
+	return nil
+}
+
+// ParseGlob parses all TypeScript files matching the pattern, in the syntax of
+// filepath.Match. Files are parsed in parallel.
+// It returns the successfully parsed files in lexical order of their paths,
+// along with the errors of the failed files joined together.
+func ParseGlob(pattern string, conf ...Config) ([]*File, error) {
+	names, err := globFiles(pattern)
+	if err != nil {
+		return nil, err
+	}
+	files, err := parseFiles(names, conf...)
+	files, _ = compactFiles(files, names)
+	return files, err
+}
+
+// globFiles returns the TypeScript files matching the pattern.
+func globFiles(pattern string) ([]string, error) {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	names := matches[:0]
+	for _, name := range matches {
+		if isSourceFile(name) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// fileNodes returns a NodeSet containing one node per file, named by names.
+func fileNodes(files []*File, names []string) NodeSet {
+	nodes := make([]Node, len(files))
+	for i, f := range files {
+		nodes[i] = Node{Name: names[i], Value: reflect.ValueOf(&f.SourceFile)}
+	}
+	return Nodes(nodes...)
+}
+
+// FromDir parses all TypeScript files under dir like ParseDir, returning a
+// NodeSet containing one node per file that parsed successfully. Each node is
+// named by the slash-separated path of the file relative to dir, e.g.
+// "src/index.ts". The errors of the failed files are returned joined together.
+func FromDir(dir string, conf ...Config) (NodeSet, error) {
+	names, err := walkDir(dir, excludeOf(conf))
+	files, err2 := parseFiles(names, conf...)
+	files, names = compactFiles(files, names)
+	for i, name := range names {
+		if rel, e := filepath.Rel(dir, name); e == nil {
+			names[i] = filepath.ToSlash(rel)
+		}
+	}
+	return fileNodes(files, names), errors.Join(err, err2)
+}
+
+// FromGlob parses all TypeScript files matching the pattern like ParseGlob,
+// returning a NodeSet containing one node per file that parsed successfully.
+// Each node is named by the path of the file as matched. The errors of the
+// failed files are returned joined together.
+func FromGlob(pattern string, conf ...Config) (NodeSet, error) {
+	names, err := globFiles(pattern)
+	if err != nil {
+		return NodeSet{NodeSet: reflects.NodeSet{Err: err}}, err
+	}
+	files, err := parseFiles(names, conf...)
+	files, names = compactFiles(files, names)
+	return fileNodes(files, names), err
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+// names returns the names of the nodes in the NodeSet.
+func names(ns ts.NodeSet) (ret []string) {
+	for node := range ns.XGo_Enum() {
+		ret = append(ret, node.XGo_name__0())
+	}
+	return
+}
+
+func TestFromDir(t *testing.T) {
+	project, err := ts.FromDir(filepath.Join("testdata", "proj"), ts.Config{Exclude: []string{"dist"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"src/index.ts", "src/lib/math.ts", "src/types.d.ts", "src/util/format.tsx", "src/util/index.ts"}
+	if got := names(project); !slices.Equal(got, want) {
+		t.Errorf("FromDir: got %q, want %q", got, want)
+	}
+	// descendant queries run across the whole project
+	if got := count(project.AnyKind(ts.KindFunctionDeclaration)); got != 3 {
+		t.Errorf("got %d functions, want 3", got)
+	}
+	if got := count(project.JsxElements("span")); got != 1 {
+		t.Errorf("got %d JSX elements, want 1", got)
+	}
+}
+
+func TestFromDirExclude(t *testing.T) {
+	dir := filepath.Join("testdata", "proj")
+	cases := []struct {
+		exclude []string
+		want    int
+	}{
+		{nil, 6}, // dist is included, node_modules and .cache are not
+		{[]string{"dist", "util"}, 3},
+		{[]string{"*.d.ts"}, 5},
+		{[]string{"src/lib"}, 5},
+		{[]string{"lib"}, 5},
+	}
+	for _, c := range cases {
+		files, err := ts.ParseDir(dir, ts.Config{Exclude: c.exclude})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != c.want {
+			t.Errorf("ParseDir with Exclude %q: got %d files, want %d", c.exclude, len(files), c.want)
+		}
+	}
+}
+
+func TestFromDirError(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "good.ts"), []byte("export const a = 1;\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "bad.ts"), []byte("export function f( {\n"), 0644)
+	project, err := ts.FromDir(dir, ts.Config{FailOnError: true})
+	if err == nil {
+		t.Error("FromDir: no error for a bad file")
+	}
+	if got := names(project); !slices.Equal(got, []string{"good.ts"}) {
+		t.Errorf("FromDir: got %q, want the good file only", got)
+	}
+	project, err = ts.FromGlob(filepath.Join(dir, "*.ts"), ts.Config{FailOnError: true})
+	if err == nil {
+		t.Error("FromGlob: no error for a bad file")
+	}
+	if got := count(project); got != 1 {
+		t.Errorf("FromGlob: got %d files, want 1", got)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"io/fs"
+	"unsafe"
+
+	"github.com/goplus/xgo/dql/reflects"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// FSPath represents a named file of a file system, as a source of Source.
+type FSPath struct {
+	FS   fs.FS
+	Name string // slash-separated path, as accepted by fs.ReadFile
+}
+
+// parseFS parses the named file of fsys. The file is named after its path in
+// fsys, rooted at "/", so it's never resolved against the working directory.
+func parseFS(fsys fs.FS, name string, conf ...Config) (*ast.SourceFile, error) {
+	b, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	var c Config
+	if len(conf) > 0 {
+		c = conf[0]
+	}
+	return parseSource("/"+name, b, c)
+}
+
+// ParseFS parses the named TypeScript file of the file system fsys, returning a
+// File object. The name of the file is "/" followed by its path in fsys, e.g.
+// "/src/index.ts". An optional Config can be provided to customize the parsing
+// behavior.
+func ParseFS(fsys fs.FS, name string, conf ...Config) (f *File, err error) {
+	doc, err := parseFS(fsys, name, conf...)
+	if err == nil {
+		f = (*File)(unsafe.Pointer(doc))
+	}
+	return
+}
+
+// FromFS parses the named TypeScript file of the file system fsys like ParseFS,
+// returning a NodeSet.
+func FromFS(fsys fs.FS, name string, conf ...Config) NodeSet {
+	f, err := parseFS(fsys, name, conf...)
+	if err != nil {
+		return NodeSet{NodeSet: reflects.NodeSet{Err: err}}
+	}
+	return New(f)
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goplus/dql/ts"
+)
+
+var fixtureFS = fstest.MapFS{
+	"src/math.ts":   {Data: []byte("export function add(a: number, b: number) { return a + b; }\n")},
+	"src/view.tsx":  {Data: []byte("export const View = () => <div className=\"view\">{1 + 2}</div>;\n")},
+	"src/broken.ts": {Data: []byte("function f( {\n")},
+}
+
+func TestParseFS(t *testing.T) {
+	f, err := ts.ParseFS(fixtureFS, "src/math.ts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := f.FileName(); name != "/src/math.ts" {
+		t.Errorf("FileName: got %q, want /src/math.ts", name)
+	}
+	if got := count(descendants(f).Kind(ts.KindFunctionDeclaration)); got != 1 {
+		t.Errorf("functions: got %d, want 1", got)
+	}
+	if _, err := ts.ParseFS(fixtureFS, "src/missing.ts"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ParseFS of a missing file: got %v, want fs.ErrNotExist", err)
+	}
+	if _, err := ts.ParseFS(fixtureFS, "src/broken.ts", ts.Config{FailOnError: true}); err == nil {
+		t.Error("ParseFS of a broken file with FailOnError: no error")
+	}
+}
+
+func TestFromFS(t *testing.T) {
+	doc := ts.FromFS(fixtureFS, "src/view.tsx")
+	if doc.Err != nil {
+		t.Fatal(doc.Err)
+	}
+	if name := doc.XGo_Attr__0("fileName"); name != "/src/view.tsx" {
+		t.Errorf("fileName: got %v, want /src/view.tsx", name)
+	}
+	// .tsx files are parsed as JSX
+	if got := doc.JsxElements("div").Count(); got != 1 {
+		t.Errorf("JsxElements: got %d, want 1", got)
+	}
+	if doc := ts.FromFS(fixtureFS, "src/missing.ts"); doc.Err == nil {
+		t.Error("FromFS of a missing file: no error")
+	}
+}
+
+func TestSourceFSPath(t *testing.T) {
+	cases := []struct {
+		name string
+		kind ts.Kind
+	}{
+		{"src/math.ts", ts.KindFunctionDeclaration},
+		{"src/view.tsx", ts.KindJsxElement},
+	}
+	for _, c := range cases {
+		doc := ts.Source(ts.FSPath{FS: fixtureFS, Name: c.name})
+		if doc.Err != nil {
+			t.Fatal(doc.Err)
+		}
+		if name := doc.XGo_Attr__0("fileName"); name != "/"+c.name {
+			t.Errorf("fileName: got %v, want /%s", name, c.name)
+		}
+		if got := doc.AnyKind(c.kind).Count(); got != 1 {
+			t.Errorf("Source(%s): got %d nodes of %v, want 1", c.name, got, c.kind)
+		}
+	}
+}
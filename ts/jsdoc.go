@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"strings"
+
+	"github.com/goplus/xgo/dql"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// jsdocsOf returns the JSDoc nodes attached to the given node. The sole
+// variable declaration of a variable statement shares the JSDoc of the
+// statement; the declarations of a statement declaring several variables
+// (const a = 1, b = 2) have no JSDoc, as it can't be told which one it's for.
+func jsdocsOf(n *ast.Node) []*ast.Node {
+	docs := n.JSDoc(nil)
+	if docs == nil && n.Kind == ast.KindVariableDeclaration {
+		list := n.Parent // a CatchClause for catch (e)
+		if list != nil && list.Kind == ast.KindVariableDeclarationList &&
+			list.Parent != nil && list.Parent.Kind == ast.KindVariableStatement &&
+			len(list.AsVariableDeclarationList().Declarations.Nodes) == 1 {
+			docs = list.Parent.JSDoc(nil)
+		}
+	}
+	return docs
+}
+
+// commentText joins the text of JSDoc comment nodes. Inline links are kept as
+// written, e.g. "{@link Foo}".
+func commentText(comments []*ast.Node) string {
+	var b strings.Builder
+	for _, c := range comments {
+		text := c.Text()
+		if c.Kind != ast.KindJSDocText {
+			if s, err := nodeText(c, false); err == nil {
+				text = s
+			}
+		}
+		b.WriteString(text)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// -----------------------------------------------------------------------------
+
+// JSDoc returns the comment text of the JSDoc attached to the first node in the
+// NodeSet, without its tags. If there are several JSDoc comments, the closest
+// one to the node is used. ErrNotFound is returned if the node has no JSDoc.
+func (p NodeSet) JSDoc() (string, error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return "", err
+	}
+	docs := jsdocsOf(n)
+	if len(docs) == 0 {
+		return "", dql.ErrNotFound
+	}
+	return commentText(docs[len(docs)-1].Comments()), nil
+}
+
+// Tags returns the text of the JSDoc tags named tagName (without the leading
+// @) attached to the first node in the NodeSet. The text of a tag with a name,
+// like @param, starts with the name, e.g. "x the x coordinate". A tag without
+// comment yields an empty string. ErrNotFound is returned if the node has no
+// JSDoc.
+func (p NodeSet) Tags(tagName string) (ret []string, err error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return
+	}
+	docs := jsdocsOf(n)
+	if len(docs) == 0 {
+		return nil, dql.ErrNotFound
+	}
+	for _, doc := range docs {
+		tags := doc.AsJSDoc().Tags
+		if tags == nil {
+			continue
+		}
+		for _, tag := range tags.Nodes {
+			if nameOf(tag.TagName()) != tagName {
+				continue
+			}
+			text := commentText(tag.Comments())
+			if name := tag.Name(); name != nil {
+				if s, err := nodeText(name, false); err == nil {
+					text = strings.TrimSpace(s + " " + text)
+				}
+			}
+			ret = append(ret, text)
+		}
+	}
+	return
+}
+
+// -----------------------------------------------------------------------------
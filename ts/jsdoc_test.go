@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+	"github.com/goplus/xgo/dql"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// named returns a NodeSet containing the declarations of the file named name.
+func named(f *ts.File, name string) ts.NodeSet {
+	return descendants(f).Filter(func(n *ast.Node) bool {
+		id := n.Name()
+		return id != nil && id.Kind == ast.KindIdentifier && id.Text() == name
+	})
+}
+
+func TestJSDoc(t *testing.T) {
+	f := parseFixture(t, "jsdoc.ts")
+	cases := []struct {
+		name, doc string
+	}{
+		{"add", "Adds two numbers."},
+		{"sub", "Subtracts b from a."},
+		{"timeout", "The default timeout in milliseconds."},
+		{"raw", "The raw configuration."},
+	}
+	for _, c := range cases {
+		doc, err := named(f, c.name).JSDoc()
+		if err != nil || doc != c.doc {
+			t.Errorf("JSDoc of %s: got %q (%v), want %q", c.name, doc, err, c.doc)
+		}
+	}
+	if _, err := named(f, "mul").JSDoc(); err != dql.ErrNotFound {
+		t.Errorf("JSDoc of an undocumented function: got %v, want ErrNotFound", err)
+	}
+	// the JSDoc of a statement declaring several variables, and variables
+	// not declared by a variable statement
+	for _, name := range []string{"minSize", "maxSize", "err", "key"} {
+		if _, err := named(f, name).JSDoc(); err != dql.ErrNotFound {
+			t.Errorf("JSDoc of %s: got %v, want ErrNotFound", name, err)
+		}
+		if _, err := named(f, name).Tags("param"); err != dql.ErrNotFound {
+			t.Errorf("Tags of %s: got %v, want ErrNotFound", name, err)
+		}
+	}
+}
+
+func TestTags(t *testing.T) {
+	f := parseFixture(t, "jsdoc.ts")
+	params, err := named(f, "add").Tags("param")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a the first operand", "b the second operand"}; !slices.Equal(params, want) {
+		t.Errorf("@param of add: got %q, want %q", params, want)
+	}
+	deprecated, err := named(f, "sub").Tags("deprecated")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"use {@link add} with a negative operand"}; !slices.Equal(deprecated, want) {
+		t.Errorf("@deprecated of sub: got %q, want %q", deprecated, want)
+	}
+	if deprecated, err = named(f, "add").Tags("deprecated"); err != nil || deprecated != nil {
+		t.Errorf("@deprecated of add: got %q (%v), want none", deprecated, err)
+	}
+	if _, err = named(f, "mul").Tags("param"); err != dql.ErrNotFound {
+		t.Errorf("Tags of an undocumented function: got %v, want ErrNotFound", err)
+	}
+}
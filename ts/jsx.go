@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"github.com/goplus/xgo/dql"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// jsxTagName returns the tag name of a JSX element as written in the source,
+// e.g. "Button", "UI.Button" or "svg:rect". It returns "" for fragments.
+func jsxTagName(n *ast.Node) (name string, ok bool) {
+	switch n.Kind {
+	case ast.KindJsxElement:
+		n = n.AsJsxElement().OpeningElement
+	case ast.KindJsxSelfClosingElement:
+	case ast.KindJsxFragment:
+		return "", true
+	default:
+		return "", false
+	}
+	name, err := nodeText(n.TagName(), false)
+	return name, err == nil
+}
+
+// JsxElements returns a NodeSet containing the JSX elements (JsxElement and
+// JsxSelfClosingElement) among the nodes in the NodeSet and their descendants
+// whose tag name equals name. Member and namespaced tag names are matched as
+// written, e.g. "UI.Button" or "svg:rect". If name is "", it returns the JSX
+// fragments (<>...</>).
+func (p NodeSet) JsxElements(name string) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			n := astNode(node)
+			if n == nil {
+				return true
+			}
+			return yieldDescendants(n, func(n *ast.Node) bool {
+				if tag, ok := jsxTagName(n); ok && tag == name {
+					return yield(syntaxNode(n))
+				}
+				return true
+			})
+		})
+	})
+}
+
+// JsxAttr returns the value of the JSX attribute with the specified name of the
+// first node in the NodeSet, which must be a JSX element. A string value is
+// returned unquoted, an expression value ({expr}) is returned as the source
+// text of the expression, and an attribute without value returns "true".
+// ErrNotFound is returned if the attribute doesn't exist.
+func (p NodeSet) JsxAttr(name string) (string, error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return "", err
+	}
+	switch n.Kind {
+	case ast.KindJsxElement:
+		n = n.AsJsxElement().OpeningElement
+	case ast.KindJsxSelfClosingElement, ast.KindJsxOpeningElement:
+	default:
+		return "", dql.ErrNotFound
+	}
+	for _, attr := range n.Attributes().Properties() {
+		if attr.Kind != ast.KindJsxAttribute { // skip spread attributes
+			continue
+		}
+		if text, err := nodeText(attr.Name(), false); err != nil || text != name {
+			continue
+		}
+		val := attr.Initializer()
+		switch {
+		case val == nil:
+			return "true", nil
+		case val.Kind == ast.KindStringLiteral:
+			return val.AsStringLiteral().Text, nil
+		case val.Kind == ast.KindJsxExpression:
+			if expr := val.Expression(); expr != nil {
+				return nodeText(expr, false)
+			}
+			return "", nil
+		default: // <X y=<Z /> />
+			return nodeText(val, false)
+		}
+	}
+	return "", dql.ErrNotFound
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"testing"
+
+	"github.com/goplus/dql/ts"
+	"github.com/goplus/xgo/dql"
+)
+
+// nth returns a NodeSet containing the i-th node of the NodeSet.
+func nth(ns ts.NodeSet, i int) ts.NodeSet {
+	for node := range ns.XGo_Enum() {
+		if i == 0 {
+			return node
+		}
+		i--
+	}
+	return ts.Nodes()
+}
+
+func TestJsxElements(t *testing.T) {
+	doc := ts.New(&parseFixture(t, "app.tsx").SourceFile)
+	cases := []struct {
+		name string
+		want int
+	}{
+		{"Button", 2},
+		{"UI.Button", 1},
+		{"Panel", 2},
+		{"Header", 1},
+		{"", 1}, // fragments
+		{"Footer", 0},
+	}
+	for _, c := range cases {
+		if got := count(doc.JsxElements(c.name)); got != c.want {
+			t.Errorf("JsxElements(%q): got %d, want %d", c.name, got, c.want)
+		}
+	}
+}
+
+func TestJsxAttr(t *testing.T) {
+	doc := ts.New(&parseFixture(t, "app.tsx").SourceFile)
+	cases := []struct {
+		elem  ts.NodeSet
+		attr  string
+		value string
+	}{
+		{doc.JsxElements("Header"), "title", "props.title"},
+		{doc.JsxElements("Panel"), "kind", "main"},
+		{nth(doc.JsxElements("Panel"), 1), "kind", "nested"},
+		{doc.JsxElements("Button"), "disabled", "true"},
+		{doc.JsxElements("Button"), "onClick", `() => alert("hi")`},
+		{doc.JsxElements("UI.Button"), "label", "Cancel"},
+	}
+	for _, c := range cases {
+		value, err := c.elem.JsxAttr(c.attr)
+		if err != nil || value != c.value {
+			t.Errorf("JsxAttr(%q): got %q (%v), want %q", c.attr, value, err, c.value)
+		}
+	}
+	if _, err := doc.JsxElements("Button").JsxAttr("label"); err != dql.ErrNotFound {
+		t.Errorf("JsxAttr of a missing attribute: got %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// isStringPiece reports whether the node is a string literal or a piece of a
+// template literal.
+func isStringPiece(n *ast.Node) bool {
+	switch n.Kind {
+	case ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral,
+		ast.KindTemplateHead, ast.KindTemplateMiddle, ast.KindTemplateTail:
+		return true
+	}
+	return false
+}
+
+// isModuleSpecifier reports whether the string literal n is the module
+// specifier of an import or export declaration, of `import x = require("m")`,
+// or of an import type (import("m").T).
+func isModuleSpecifier(n *ast.Node) bool {
+	p := n.Parent
+	if p == nil {
+		return false
+	}
+	switch p.Kind {
+	case ast.KindImportDeclaration:
+		return p.AsImportDeclaration().ModuleSpecifier == n
+	case ast.KindExportDeclaration:
+		return p.AsExportDeclaration().ModuleSpecifier == n
+	case ast.KindExternalModuleReference:
+		return true
+	case ast.KindLiteralType:
+		return p.Parent != nil && p.Parent.Kind == ast.KindImportType
+	}
+	return false
+}
+
+// StringValues returns the cooked text (with escape sequences decoded) of the
+// string literals and template literals among the nodes in the NodeSet, or
+// among the nodes and all their descendants if descendants is true. A template
+// with substitutions yields the text of each piece around the substitutions,
+// e.g. `a${x}b${y}` yields "a", "b" and "". Module specifiers of imports and
+// exports are skipped.
+func (p NodeSet) StringValues(descendants bool) (ret []string, err error) {
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	add := func(n *ast.Node) bool {
+		if isStringPiece(n) && !isModuleSpecifier(n) {
+			ret = append(ret, n.Text())
+		}
+		return true
+	}
+	p.Data(func(node Node) bool {
+		n := astNode(node)
+		if n == nil {
+			err = ErrNotSyntaxNode
+			return false
+		}
+		switch {
+		case descendants:
+			yieldDescendants(n, add)
+		case n.Kind == ast.KindTemplateExpression:
+			expr := n.AsTemplateExpression()
+			add(expr.Head)
+			for _, span := range expr.TemplateSpans.Nodes {
+				add(span.AsTemplateSpan().Literal)
+			}
+		default:
+			add(n)
+		}
+		return true
+	})
+	return
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+const literalSrc = `import { t } from "./i18n";
+export * from "./strings";
+
+const title = "Say \"hello\"";
+const note = 'it\'s\tdone';
+const greeting = ` + "`Hello, ${user.name}! You have ${count} new messages.`" + `;
+const plain = ` + "`no substitution`" + `;
+let kind: import("./types").Kind;
+`
+
+func TestStringValues(t *testing.T) {
+	doc := ts.From("", literalSrc)
+	vals, err := doc.StringValues(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`Say "hello"`, "it's\tdone",
+		"Hello, ", "! You have ", " new messages.",
+		"no substitution",
+	}
+	if !slices.Equal(vals, want) {
+		t.Errorf("StringValues(true):\ngot  %q\nwant %q", vals, want)
+	}
+
+	// only the nodes themselves
+	tpl := doc.AnyKind(ts.KindTemplateExpression)
+	if vals, err := tpl.StringValues(false); err != nil || !slices.Equal(vals, want[2:5]) {
+		t.Errorf("StringValues(false) of a template: got %q (%v), want %q", vals, err, want[2:5])
+	}
+	if vals, err := doc.AnyKind(ts.KindVariableStatement).StringValues(false); err != nil || vals != nil {
+		t.Errorf("StringValues(false) of statements: got %q (%v), want none", vals, err)
+	}
+	if _, err := doc.XGo_Elem("fileName").StringValues(true); err != ts.ErrNotSyntaxNode {
+		t.Errorf("StringValues of a non-syntax node: got %v, want ErrNotSyntaxNode", err)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// membersOf returns the members of a class, interface, enum or type literal.
+// It returns nil for other kinds of nodes.
+func membersOf(n *ast.Node) []*ast.Node {
+	switch n.Kind {
+	case ast.KindClassDeclaration, ast.KindClassExpression, ast.KindInterfaceDeclaration,
+		ast.KindEnumDeclaration, ast.KindTypeLiteral:
+		return n.Members()
+	}
+	return nil
+}
+
+// Members returns a NodeSet containing the members of the class, interface,
+// enum and type literal nodes in the NodeSet, such as methods, properties,
+// accessors and constructors. If kinds are given, only the members of these
+// kinds are returned, e.g. Members(KindGetAccessor, KindSetAccessor).
+func (p NodeSet) Members(kinds ...Kind) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			n := astNode(node)
+			if n == nil {
+				return true
+			}
+			for _, m := range membersOf(n) {
+				if len(kinds) == 0 || slices.Contains(kinds, m.Kind) {
+					if !yield(syntaxNode(m)) {
+						return false
+					}
+				}
+			}
+			return true
+		})
+	})
+}
+
+// MemberNamed returns a NodeSet containing the members of the nodes in the
+// NodeSet (see Members) with the specified name. Names are matched like the
+// names of Declarations: string and numeric literal names unquoted, and other
+// computed names as written, e.g. "[Symbol.iterator]". Constructors are named
+// "constructor". All overloads of a method are returned.
+func (p NodeSet) MemberNamed(name string) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Members().Data(func(node Node) bool {
+			if declName(astNode(node)) == name {
+				return yield(node)
+			}
+			return true
+		})
+	})
+}
+
+// -----------------------------------------------------------------------------
+
+// modifierKindsOf returns the modifier keywords of the node, without its
+// decorators.
+func modifierKindsOf(n *ast.Node) (ret []Kind) {
+	for _, m := range n.ModifierNodes() {
+		if m.Kind != ast.KindDecorator {
+			ret = append(ret, m.Kind)
+		}
+	}
+	return
+}
+
+// Modifiers returns the modifier keywords of the first node in the NodeSet, such
+// as KindExportKeyword, KindStaticKeyword or KindReadonlyKeyword, in source
+// order. Decorators are not included.
+func (p NodeSet) Modifiers() ([]Kind, error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return nil, err
+	}
+	return modifierKindsOf(n), nil
+}
+
+// WithModifier returns a NodeSet containing the nodes in the NodeSet carrying a
+// modifier keyword of the given kind, e.g. WithModifier(KindStaticKeyword).
+// Members with a #private name are considered to carry KindPrivateKeyword.
+// Call WithModifier several times to require several modifiers.
+func (p NodeSet) WithModifier(kind Kind) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			n := astNode(node)
+			if n == nil {
+				return true
+			}
+			if hasModifier(n, kind) || kind == ast.KindPrivateKeyword && isPrivateName(n.Name()) {
+				return yield(node)
+			}
+			return true
+		})
+	})
+}
+
+// -----------------------------------------------------------------------------
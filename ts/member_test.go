@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+func TestMembers(t *testing.T) {
+	f := parseFixture(t, "members.ts")
+	counter := named(f, "Counter").Kind(ts.KindClassDeclaration)
+	cases := []struct {
+		kinds []ts.Kind
+		want  int
+	}{
+		{nil, 11},
+		{[]ts.Kind{ts.KindMethodDeclaration}, 5},
+		{[]ts.Kind{ts.KindGetAccessor, ts.KindSetAccessor}, 2},
+		{[]ts.Kind{ts.KindPropertyDeclaration}, 3},
+		{[]ts.Kind{ts.KindConstructor}, 1},
+	}
+	for _, c := range cases {
+		if got := count(counter.Members(c.kinds...)); got != c.want {
+			t.Errorf("Members(%v): got %d, want %d", c.kinds, got, c.want)
+		}
+	}
+	shape := named(f, "Shape")
+	if got := kindsOf(shape.Members()); !slices.Equal(got, []ts.Kind{ts.KindPropertySignature, ts.KindMethodSignature}) {
+		t.Errorf("Members of an interface: got %v", got)
+	}
+}
+
+func TestMemberNamed(t *testing.T) {
+	counter := named(parseFixture(t, "members.ts"), "Counter").Kind(ts.KindClassDeclaration)
+	cases := []struct {
+		name  string
+		kinds []ts.Kind
+	}{
+		{"create", []ts.Kind{ts.KindMethodDeclaration, ts.KindMethodDeclaration, ts.KindMethodDeclaration}},
+		{"count", []ts.Kind{ts.KindGetAccessor, ts.KindSetAccessor}},
+		{"constructor", []ts.Kind{ts.KindConstructor}},
+		{"#instances", []ts.Kind{ts.KindPropertyDeclaration}},
+		{"reset-all", []ts.Kind{ts.KindMethodDeclaration}},
+		{"[Symbol.iterator]", []ts.Kind{ts.KindMethodDeclaration}},
+		{"destroy", nil},
+	}
+	for _, c := range cases {
+		if got := kindsOf(counter.MemberNamed(c.name)); !slices.Equal(got, c.kinds) {
+			t.Errorf("MemberNamed(%q): got %v, want %v", c.name, got, c.kinds)
+		}
+	}
+	// does class Counter have a static create method?
+	create := counter.MemberNamed("create").WithModifier(ts.KindStaticKeyword)
+	if got := count(create); got != 3 {
+		t.Errorf("static create: got %d members, want 3", got)
+	}
+}
+
+func TestModifiers(t *testing.T) {
+	counter := named(parseFixture(t, "members.ts"), "Counter").Kind(ts.KindClassDeclaration)
+	mods, err := counter.MemberNamed("registry").Modifiers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []ts.Kind{ts.KindPrivateKeyword, ts.KindStaticKeyword, ts.KindReadonlyKeyword}; !slices.Equal(mods, want) {
+		t.Errorf("Modifiers: got %v, want %v", mods, want)
+	}
+	if mods, err := counter.Modifiers(); err != nil || !slices.Equal(mods, []ts.Kind{ts.KindExportKeyword}) {
+		t.Errorf("Modifiers of the class: got %v (%v)", mods, err)
+	}
+
+	members := counter.Members()
+	cases := []struct {
+		mods []ts.Kind
+		want int
+	}{
+		{[]ts.Kind{ts.KindStaticKeyword}, 5},
+		{[]ts.Kind{ts.KindPrivateKeyword}, 3},
+		{[]ts.Kind{ts.KindPrivateKeyword, ts.KindStaticKeyword}, 2},
+		{[]ts.Kind{ts.KindReadonlyKeyword}, 1},
+		{[]ts.Kind{ts.KindAbstractKeyword}, 0},
+	}
+	for _, c := range cases {
+		ns := members
+		for _, mod := range c.mods {
+			ns = ns.WithModifier(mod)
+		}
+		if got := count(ns); got != c.want {
+			t.Errorf("WithModifier(%v): got %d, want %d", c.mods, got, c.want)
+		}
+	}
+}
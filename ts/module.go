@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"unsafe"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// ImportBinding represents a name bound by an import declaration.
+type ImportBinding struct {
+	Name     string // local name
+	Imported string // imported name, the same as Name if not aliased
+	TypeOnly bool   // import { type X }
+}
+
+// ImportInfo represents an import declaration.
+type ImportInfo struct {
+	Module    string          // module specifier
+	Default   string          // default binding, if any
+	Namespace string          // namespace binding (import * as ns, import ns = require()), if any
+	Named     []ImportBinding // named bindings
+	TypeOnly  bool            // import type ...
+}
+
+// ImportsOf returns the import declarations of the given file, including
+// `import x = require("m")` forms. Side-effect imports (import "m") have no
+// bindings.
+func ImportsOf(f *File) (ret []ImportInfo, err error) {
+	for _, stmt := range f.Statements.Nodes {
+		switch stmt.Kind {
+		case ast.KindImportDeclaration:
+			decl := stmt.AsImportDeclaration()
+			info := ImportInfo{}
+			if info.Module, err = moduleSpecifier(decl.ModuleSpecifier); err != nil {
+				return
+			}
+			if clause := decl.ImportClause; clause != nil {
+				info.TypeOnly = clause.IsTypeOnly()
+				if name := clause.Name(); name != nil {
+					info.Default = nameOf(name)
+				}
+				if bindings := clause.AsImportClause().NamedBindings; bindings != nil {
+					switch bindings.Kind {
+					case ast.KindNamespaceImport:
+						info.Namespace = nameOf(bindings.Name())
+					case ast.KindNamedImports:
+						for _, spec := range bindings.AsNamedImports().Elements.Nodes {
+							name := nameOf(spec.Name())
+							info.Named = append(info.Named, ImportBinding{
+								Name:     name,
+								Imported: nameOf(spec.PropertyNameOrName()),
+								TypeOnly: spec.IsTypeOnly(),
+							})
+						}
+					}
+				}
+			}
+			ret = append(ret, info)
+		case ast.KindImportEqualsDeclaration:
+			decl := stmt.AsImportEqualsDeclaration()
+			ref := decl.ModuleReference
+			if ref.Kind != ast.KindExternalModuleReference {
+				continue // import a = NS.b, not a module import
+			}
+			info := ImportInfo{Namespace: nameOf(stmt.Name()), TypeOnly: decl.IsTypeOnly}
+			if info.Module, err = moduleSpecifier(ref.AsExternalModuleReference().Expression); err != nil {
+				return
+			}
+			ret = append(ret, info)
+		}
+	}
+	return
+}
+
+// -----------------------------------------------------------------------------
+
+// ExportInfo represents a name exported by a file.
+//
+// Name is the exported name. It is "default" for default exports, "*" for
+// `export * from "m"`, and "=" for `export = x`.
+//
+// Local is the local name being exported, or the name of the exported
+// declaration. It is empty for anonymous default exports and for expressions,
+// and "*" for `export * as ns from "m"`.
+type ExportInfo struct {
+	Name     string
+	Local    string
+	Module   string // module specifier of a re-export, if any
+	TypeOnly bool   // export type { ... }
+	Kind     Kind   // kind of the exported declaration or export statement
+}
+
+// ExportsOf returns the names exported by the top-level statements of the
+// given file: inline exports (export class Foo), export lists, re-exports and
+// default exports.
+func ExportsOf(f *File) (ret []ExportInfo, err error) {
+	for _, stmt := range f.Statements.Nodes {
+		switch stmt.Kind {
+		case ast.KindExportDeclaration:
+			decl := stmt.AsExportDeclaration()
+			var module string
+			if decl.ModuleSpecifier != nil {
+				if module, err = moduleSpecifier(decl.ModuleSpecifier); err != nil {
+					return
+				}
+			}
+			clause := decl.ExportClause
+			switch {
+			case clause == nil:
+				ret = append(ret, ExportInfo{Name: "*", Module: module, TypeOnly: decl.IsTypeOnly, Kind: stmt.Kind})
+			case clause.Kind == ast.KindNamespaceExport:
+				ret = append(ret, ExportInfo{
+					Name: nameOf(clause.Name()), Local: "*", Module: module, TypeOnly: decl.IsTypeOnly, Kind: stmt.Kind,
+				})
+			default:
+				for _, spec := range clause.AsNamedExports().Elements.Nodes {
+					ret = append(ret, ExportInfo{
+						Name:     nameOf(spec.Name()),
+						Local:    nameOf(spec.PropertyNameOrName()),
+						Module:   module,
+						TypeOnly: decl.IsTypeOnly || spec.IsTypeOnly(),
+						Kind:     stmt.Kind,
+					})
+				}
+			}
+		case ast.KindExportAssignment:
+			decl := stmt.AsExportAssignment()
+			info := ExportInfo{Name: "default", Kind: stmt.Kind}
+			if decl.IsExportEquals {
+				info.Name = "="
+			}
+			if expr := decl.Expression; expr.Kind == ast.KindIdentifier {
+				info.Local = nameOf(expr)
+			}
+			ret = append(ret, info)
+		default:
+			if !hasModifier(stmt, ast.KindExportKeyword) {
+				continue
+			}
+			if stmt.Kind == ast.KindVariableStatement {
+				list := stmt.AsVariableStatement().DeclarationList.AsVariableDeclarationList()
+				for _, decl := range list.Declarations.Nodes {
+					if name := decl.Name(); name.Kind == ast.KindIdentifier { // skip destructuring
+						ret = append(ret, ExportInfo{Name: nameOf(name), Local: nameOf(name), Kind: stmt.Kind})
+					}
+				}
+				continue
+			}
+			var local string
+			if name := stmt.Name(); name != nil {
+				local = nameOf(name)
+			}
+			name := local
+			if hasModifier(stmt, ast.KindDefaultKeyword) {
+				name = "default"
+			}
+			ret = append(ret, ExportInfo{Name: name, Local: local, Kind: stmt.Kind})
+		}
+	}
+	return
+}
+
+// -----------------------------------------------------------------------------
+
+// exportedLocals returns the local names exported by the export lists (export
+// { Foo }, export { Foo as Bar }) and default exports (export default Foo) of
+// the given file. Re-exports from other modules are not included.
+func exportedLocals(f *ast.SourceFile) map[string]bool {
+	ret := make(map[string]bool)
+	exports, _ := ExportsOf((*File)(unsafe.Pointer(f)))
+	for _, exp := range exports {
+		if exp.Module == "" && exp.Local != "" && exp.Local != "*" {
+			ret[exp.Local] = true
+		}
+	}
+	return ret
+}
+
+// Exported returns a NodeSet containing the declarations in the NodeSet that
+// are exported: either inline (export class Foo, export default function f),
+// or at the top level of a file that exports them by name (export { Foo },
+// export default Foo). A variable declaration is exported if its variable
+// statement is. Other nodes are skipped.
+func (p NodeSet) Exported() NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		locals := make(map[*ast.SourceFile]map[string]bool)
+		p.Data(func(node Node) bool {
+			n := astNode(node)
+			if n == nil {
+				return true
+			}
+			decl, name := n, n.Name()
+			if n.Kind == ast.KindVariableDeclaration {
+				if decl = n.Parent.Parent; decl.Kind != ast.KindVariableStatement {
+					return true // for (const x of xs)
+				}
+			}
+			exported := hasModifier(decl, ast.KindExportKeyword)
+			if !exported && name != nil && decl.Parent != nil && decl.Parent.Kind == ast.KindSourceFile {
+				f := decl.Parent.AsSourceFile()
+				if locals[f] == nil {
+					locals[f] = exportedLocals(f)
+				}
+				exported = locals[f][nameOf(name)]
+			}
+			if exported {
+				return yield(node)
+			}
+			return true
+		})
+	})
+}
+
+// -----------------------------------------------------------------------------
+
+// hasModifier reports whether the node carries a modifier of the given kind.
+func hasModifier(n *ast.Node, kind Kind) bool {
+	for _, m := range n.ModifierNodes() {
+		if m.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// nameOf returns the text of a name node (Identifier, PrivateIdentifier,
+// StringLiteral, etc.). It returns "" for other kinds of nodes.
+func nameOf(n *ast.Node) string {
+	if v, ok := nodeValue(n); ok {
+		if s, ok := v.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+// moduleSpecifier returns the unquoted module specifier.
+func moduleSpecifier(n *ast.Node) (string, error) {
+	if n == nil || n.Kind != ast.KindStringLiteral {
+		return "", errorAt(n, "module specifier must be a string literal")
+	}
+	return n.AsStringLiteral().Text, nil
+}
+
+// -----------------------------------------------------------------------------
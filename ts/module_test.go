@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+func TestImportsOf(t *testing.T) {
+	imports, err := ts.ImportsOf(parseFixture(t, "module.ts"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ts.ImportInfo{
+		{Module: "react", Default: "React"},
+		{Module: "path", Namespace: "path"},
+		{Module: "fs", Named: []ts.ImportBinding{
+			{Name: "readFile", Imported: "readFile"},
+			{Name: "write", Imported: "writeFile"},
+			{Name: "Stats", Imported: "Stats", TypeOnly: true},
+		}},
+		{Module: "./config", Named: []ts.ImportBinding{{Name: "Config", Imported: "Config"}}, TypeOnly: true},
+		{Module: "./both", Default: "Default", Named: []ts.ImportBinding{{Name: "named", Imported: "named"}}},
+		{Module: "./polyfill"},
+		{Module: "fs", Namespace: "fs"},
+	}
+	if !reflect.DeepEqual(imports, want) {
+		t.Errorf("ImportsOf:\ngot  %+v\nwant %+v", imports, want)
+	}
+}
+
+func TestExportsOf(t *testing.T) {
+	exports, err := ts.ExportsOf(parseFixture(t, "module.ts"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ts.ExportInfo{
+		{Name: "version", Local: "version", Kind: ts.KindVariableStatement},
+		{Name: "build", Local: "build", Kind: ts.KindVariableStatement},
+		{Name: "helper", Local: "helper", Kind: ts.KindFunctionDeclaration},
+		{Name: "default", Local: "App", Kind: ts.KindClassDeclaration},
+		{Name: "Impl", Local: "Internal", Kind: ts.KindExportDeclaration},
+		{Name: "Config", Local: "Config", TypeOnly: true, Kind: ts.KindExportDeclaration},
+		{Name: "*", Module: "./util", Kind: ts.KindExportDeclaration},
+		{Name: "ns", Local: "*", Module: "./ns", Kind: ts.KindExportDeclaration},
+		{Name: "a", Local: "a", Module: "./re", Kind: ts.KindExportDeclaration},
+		{Name: "c", Local: "b", Module: "./re", Kind: ts.KindExportDeclaration},
+	}
+	if !reflect.DeepEqual(exports, want) {
+		t.Errorf("ExportsOf:\ngot  %+v\nwant %+v", exports, want)
+	}
+}
+
+func TestExportAssignment(t *testing.T) {
+	f, err := ts.ParseFile("", "const x = 1;\nexport = x;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exports, err := ts.ExportsOf(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ts.ExportInfo{{Name: "=", Local: "x", Kind: ts.KindExportAssignment}}
+	if !reflect.DeepEqual(exports, want) {
+		t.Errorf("ExportsOf: got %+v, want %+v", exports, want)
+	}
+}
+
+func TestExported(t *testing.T) {
+	decls := descendants(parseFixture(t, "exported.ts")).Kind(ts.KindClassDeclaration,
+		ts.KindFunctionDeclaration, ts.KindVariableDeclaration)
+	var got []string
+	for decl := range decls.Exported().XGo_Enum() {
+		got = append(got, decl.XGo_Attr__0("name").(string))
+	}
+	want := []string{"Store", "version", "main", "helper", "limit"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Exported: got %q, want %q", got, want)
+	}
+
+	// export default of a declared name
+	doc := ts.From("", "class Widget {}\nclass Other {}\nexport default Widget;\n")
+	got = nil
+	for decl := range doc.AnyKind(ts.KindClassDeclaration).Exported().XGo_Enum() {
+		got = append(got, decl.XGo_Attr__0("name").(string))
+	}
+	if want := []string{"Widget"}; !slices.Equal(got, want) {
+		t.Errorf("Exported with export default: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,339 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+
+	"github.com/goplus/xgo/dql"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+var (
+	ErrNotSyntaxNode = errors.New("not a TypeScript syntax node")
+	ErrNoSourceFile  = errors.New("node doesn't belong to a source file")
+)
+
+// -----------------------------------------------------------------------------
+
+// astNoder is implemented by *ast.Node and all concrete syntax node types
+// (*ast.SourceFile, *ast.Identifier, etc.) that embed ast.NodeBase.
+type astNoder interface {
+	AsNode() *ast.Node
+}
+
+// astNode returns the TypeScript syntax node behind the given node.
+// It returns nil if the node doesn't hold a syntax node.
+func astNode(node Node) *ast.Node {
+	v := node.Value
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Pointer || v.IsNil() || !v.CanInterface() {
+		return nil
+	}
+	if n, ok := v.Interface().(astNoder); ok {
+		return n.AsNode()
+	}
+	return nil
+}
+
+// syntaxNode returns a Node holding the given syntax node.
+func syntaxNode(n *ast.Node) Node {
+	return Node{Value: reflect.ValueOf(n)}
+}
+
+// yieldDescendants yields the syntax node and all its descendants in
+// depth-first order. It returns false if yield returns false.
+func yieldDescendants(n *ast.Node, yield func(*ast.Node) bool) bool {
+	if !yield(n) {
+		return false
+	}
+	return !n.ForEachChild(func(child *ast.Node) bool {
+		return !yieldDescendants(child, yield)
+	})
+}
+
+// firstAST returns the syntax node behind the first node in the NodeSet.
+func (p NodeSet) firstAST() (*ast.Node, error) {
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	node, err := dql.First(p.Data)
+	if err != nil {
+		return nil, err
+	}
+	if n := astNode(node); n != nil {
+		return n, nil
+	}
+	return nil, ErrNotSyntaxNode
+}
+
+// nodeValue returns the Go value represented by a name or literal node:
+//   - Identifier, PrivateIdentifier: the name
+//   - StringLiteral, NoSubstitutionTemplateLiteral: the unquoted text, with
+//     escape sequences decoded
+//   - NumericLiteral, BigIntLiteral: the literal text, as normalized by the
+//     parser (e.g. "31" for 0x1F)
+//   - TrueKeyword, FalseKeyword: a bool
+//   - ComputedPropertyName: the source text of the expression, e.g.
+//     "Symbol.iterator" for [Symbol.iterator]
+//   - JsxNamespacedName: the name as written, e.g. "svg:rect"
+//   - LiteralType: the value of the literal, e.g. "a" for type T = "a"
+//
+// It returns false for other kinds of nodes.
+func nodeValue(n *ast.Node) (any, bool) {
+	switch n.Kind {
+	case ast.KindComputedPropertyName:
+		if text, err := nodeText(n.Expression(), false); err == nil {
+			return text, true
+		}
+	case ast.KindJsxNamespacedName:
+		return n.Text(), true
+	case ast.KindLiteralType:
+		return nodeValue(n.AsLiteralTypeNode().Literal)
+	case ast.KindIdentifier:
+		return n.AsIdentifier().Text, true
+	case ast.KindPrivateIdentifier:
+		return n.AsPrivateIdentifier().Text, true
+	case ast.KindStringLiteral:
+		return n.AsStringLiteral().Text, true
+	case ast.KindNoSubstitutionTemplateLiteral:
+		return n.AsNoSubstitutionTemplateLiteral().Text, true
+	case ast.KindNumericLiteral:
+		return n.AsNumericLiteral().Text, true
+	case ast.KindBigIntLiteral:
+		return n.AsBigIntLiteral().Text, true
+	case ast.KindTrueKeyword:
+		return true, true
+	case ast.KindFalseKeyword:
+		return false, true
+	}
+	return nil, false
+}
+
+// -----------------------------------------------------------------------------
+
+// Kind returns a NodeSet containing the nodes in the NodeSet whose syntax kind
+// matches any of the given kinds. Only the nodes themselves are checked, not
+// their descendants. Nodes that don't hold a syntax node are skipped.
+func (p NodeSet) Kind(kinds ...Kind) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			if n := astNode(node); n != nil && slices.Contains(kinds, n.Kind) {
+				return yield(node)
+			}
+			return true
+		})
+	})
+}
+
+// anyKind returns a NodeSet containing the nodes in the NodeSet and all their
+// descendants whose syntax kind satisfies match, in depth-first order.
+func (p NodeSet) anyKind(match func(Kind) bool) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			n := astNode(node)
+			if n == nil {
+				return true
+			}
+			return yieldDescendants(n, func(n *ast.Node) bool {
+				if match(n.Kind) {
+					return yield(syntaxNode(n))
+				}
+				return true
+			})
+		})
+	})
+}
+
+// AnyKind returns a NodeSet containing the nodes in the NodeSet and all their
+// descendants whose syntax kind matches any of the given kinds, in depth-first
+// order. It's the descendant version of Kind, e.g. AnyKind(KindCallExpression)
+// returns all calls of a file. Nodes that don't hold a syntax node are skipped.
+func (p NodeSet) AnyKind(kinds ...Kind) NodeSet {
+	return p.anyKind(func(kind Kind) bool {
+		return slices.Contains(kinds, kind)
+	})
+}
+
+// Statements returns a NodeSet containing the statements among the nodes in
+// the NodeSet and all their descendants, that is the nodes whose kind is in
+// the range KindFirstStatement..KindLastStatement: variable, expression, if,
+// loop, return, throw, try statements, etc. Blocks, empty statements and
+// declarations such as functions and classes are not in this range.
+func (p NodeSet) Statements() NodeSet {
+	return p.anyKind(func(kind Kind) bool {
+		return kind >= KindFirstStatement && kind <= KindLastStatement
+	})
+}
+
+// TypeNodes returns a NodeSet containing the type nodes among the nodes in the
+// NodeSet and all their descendants, that is the nodes whose kind is in the
+// range KindFirstTypeNode..KindLastTypeNode: type references, function types,
+// array, union and literal types, etc. Keyword types such as number and string
+// are tokens outside of this range.
+func (p NodeSet) TypeNodes() NodeSet {
+	return p.anyKind(func(kind Kind) bool {
+		return kind >= KindFirstTypeNode && kind <= KindLastTypeNode
+	})
+}
+
+// Expressions returns a NodeSet containing the expressions among the nodes in
+// the NodeSet and all their descendants. Expressions are recognized by their
+// kind only, as listed in isExpressionKind, so identifiers used as declaration
+// names are included.
+func (p NodeSet) Expressions() NodeSet {
+	return p.anyKind(isExpressionKind)
+}
+
+// isExpressionKind reports whether kind is the kind of an expression, like
+// ast.IsExpression of the TypeScript compiler.
+func isExpressionKind(kind Kind) bool {
+	switch kind {
+	// unary and left-hand side expressions
+	case KindPrefixUnaryExpression, KindPostfixUnaryExpression, KindDeleteExpression,
+		KindTypeOfExpression, KindVoidExpression, KindAwaitExpression, KindTypeAssertionExpression,
+		KindPropertyAccessExpression, KindElementAccessExpression, KindNewExpression, KindCallExpression,
+		KindJsxElement, KindJsxSelfClosingElement, KindJsxFragment, KindTaggedTemplateExpression,
+		KindArrayLiteralExpression, KindParenthesizedExpression, KindObjectLiteralExpression,
+		KindClassExpression, KindFunctionExpression, KindIdentifier, KindPrivateIdentifier,
+		KindRegularExpressionLiteral, KindNumericLiteral, KindBigIntLiteral, KindStringLiteral,
+		KindNoSubstitutionTemplateLiteral, KindTemplateExpression, KindFalseKeyword, KindNullKeyword,
+		KindThisKeyword, KindTrueKeyword, KindSuperKeyword, KindNonNullExpression,
+		KindExpressionWithTypeArguments, KindMetaProperty, KindImportKeyword, KindMissingDeclaration:
+		return true
+	// other expressions
+	case KindConditionalExpression, KindYieldExpression, KindArrowFunction, KindBinaryExpression,
+		KindSpreadElement, KindAsExpression, KindOmittedExpression, KindCommaListExpression,
+		KindPartiallyEmittedExpression, KindSatisfiesExpression:
+		return true
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
+
+// Filter returns a NodeSet containing the nodes in the NodeSet whose syntax node
+// satisfies pred, e.g. call expressions with more than 3 arguments:
+//
+//	ns.Filter(func(n *ast.Node) bool {
+//		return n.Kind == ast.KindCallExpression && len(n.Arguments()) > 3
+//	})
+//
+// Nodes that don't hold a syntax node are skipped without calling pred.
+func (p NodeSet) Filter(pred func(*ast.Node) bool) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			if n := astNode(node); n != nil && pred(n) {
+				return yield(node)
+			}
+			return true
+		})
+	})
+}
+
+// -----------------------------------------------------------------------------
+
+// Count returns the number of nodes in the NodeSet. It returns 0 if the NodeSet
+// has an error.
+func (p NodeSet) Count() (n int) {
+	if p.Err != nil {
+		return 0
+	}
+	p.Data(func(Node) bool {
+		n++
+		return true
+	})
+	return
+}
+
+// CountByKind returns the number of nodes in the NodeSet per syntax kind, in a
+// single pass. ErrNotSyntaxNode is returned if a node doesn't hold a syntax
+// node.
+func (p NodeSet) CountByKind() (ret map[Kind]int, err error) {
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	ret = make(map[Kind]int)
+	p.Data(func(node Node) bool {
+		n := astNode(node)
+		if n == nil {
+			err = ErrNotSyntaxNode
+			return false
+		}
+		ret[n.Kind]++
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// -----------------------------------------------------------------------------
+
+// Parent returns a NodeSet containing the parents of the nodes in the NodeSet,
+// following the parent links of the syntax tree. A parent shared by several
+// nodes is returned once. Nodes without parent (source files) and nodes that
+// don't hold a syntax node are skipped.
+func (p NodeSet) Parent() NodeSet {
+	return p.Ancestors()
+}
+
+// Ancestors returns a NodeSet containing, for each node in the NodeSet, its
+// nearest ancestor whose syntax kind matches any of the given kinds, e.g. the
+// enclosing functions with Ancestors(KindFunctionDeclaration, KindArrowFunction).
+// If no kinds are given, the parent of each node is returned. An ancestor
+// shared by several nodes is returned once.
+func (p NodeSet) Ancestors(kinds ...Kind) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		seen := make(map[*ast.Node]bool)
+		p.Data(func(node Node) bool {
+			n := astNode(node)
+			if n == nil {
+				return true
+			}
+			for n = n.Parent; n != nil; n = n.Parent {
+				if len(kinds) == 0 || slices.Contains(kinds, n.Kind) {
+					if seen[n] {
+						return true
+					}
+					seen[n] = true
+					return yield(syntaxNode(n))
+				}
+			}
+			return true
+		})
+	})
+}
+
+// -----------------------------------------------------------------------------
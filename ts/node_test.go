@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+	"github.com/goplus/xgo/dql"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// parseFixture parses the named file of testdata.
+func parseFixture(t testing.TB, name string, conf ...ts.Config) *ts.File {
+	t.Helper()
+	f, err := ts.ParseFile(filepath.Join("testdata", name), nil, conf...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// descendants returns a NodeSet containing all syntax nodes of the file.
+func descendants(f *ts.File) ts.NodeSet {
+	var nodes []ts.Node
+	var walk func(n *ast.Node) bool
+	walk = func(n *ast.Node) bool {
+		nodes = append(nodes, ts.Node{Value: reflect.ValueOf(n)})
+		return n.ForEachChild(walk)
+	}
+	walk(f.AsNode())
+	return ts.Nodes(nodes...)
+}
+
+// count returns the number of nodes in the NodeSet.
+func count(ns ts.NodeSet) (n int) {
+	for range ns.XGo_Enum() {
+		n++
+	}
+	return
+}
+
+func TestKind(t *testing.T) {
+	all := descendants(parseFixture(t, "sample.ts"))
+	cases := []struct {
+		kinds []ts.Kind
+		want  int
+	}{
+		{[]ts.Kind{ts.KindFunctionDeclaration}, 2},
+		{[]ts.Kind{ts.KindFunctionDeclaration, ts.KindArrowFunction, ts.KindFunctionExpression}, 4},
+		{[]ts.Kind{ts.KindClassDeclaration}, 2},
+		{[]ts.Kind{ts.KindCallExpression}, 7},
+		{[]ts.Kind{ts.KindInterfaceDeclaration}, 0},
+	}
+	for _, c := range cases {
+		if got := count(all.Kind(c.kinds...)); got != c.want {
+			t.Errorf("Kind(%v): got %d nodes, want %d", c.kinds, got, c.want)
+		}
+	}
+}
+
+func TestKindCurrentSet(t *testing.T) {
+	f := parseFixture(t, "sample.ts")
+	if got := count(ts.New(&f.SourceFile).Kind(ts.KindFunctionDeclaration)); got != 0 {
+		t.Errorf("Kind on a source file: got %d nodes, want 0", got)
+	}
+	if got := count(ts.New(&f.SourceFile).Kind(ts.KindSourceFile)); got != 1 {
+		t.Errorf("Kind(KindSourceFile): got %d nodes, want 1", got)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	all := descendants(parseFixture(t, "sample.ts"))
+	calls := func(pred func(args int) bool) ts.NodeSet {
+		return all.Filter(func(n *ast.Node) bool {
+			return n.Kind == ast.KindCallExpression && pred(len(n.Arguments()))
+		})
+	}
+	cases := []struct {
+		desc string
+		pred func(args int) bool
+		want []string
+	}{
+		{"2+ arguments", func(args int) bool { return args >= 2 }, []string{`readFile(path, "utf8")`}},
+		{"no argument", func(args int) bool { return args == 0 }, []string{"line.trim()"}},
+		{"1 argument", func(args int) bool { return args == 1 }, []string{
+			`text.split("\n")`, "lines.map((line) => line.trim())", "this.items.push(item)",
+			`console.log(parse("a\nb"))`, `parse("a\nb")`,
+		}},
+	}
+	for _, c := range cases {
+		texts, err := calls(c.pred).Texts()
+		if err != nil || !slices.Equal(texts, c.want) {
+			t.Errorf("calls with %s: got %q (%v), want %q", c.desc, texts, err, c.want)
+		}
+	}
+	// nodes that don't hold a syntax node are skipped without calling pred
+	f := parseFixture(t, "sample.ts")
+	ns := ts.New(&f.SourceFile).XGo_Elem("fileName").Filter(func(*ast.Node) bool {
+		t.Error("pred called for a non-syntax node")
+		return true
+	})
+	if got := count(ns); got != 0 {
+		t.Errorf("Filter of a non-syntax node: got %d nodes, want 0", got)
+	}
+}
+
+func TestCount(t *testing.T) {
+	all := descendants(parseFixture(t, "sample.ts"))
+	if got := all.Kind(ts.KindFunctionDeclaration).Count(); got != 2 {
+		t.Errorf("Count of functions: got %d, want 2", got)
+	}
+	if got := all.Kind(ts.KindEnumDeclaration).Count(); got != 0 {
+		t.Errorf("Count of enums: got %d, want 0", got)
+	}
+	if got := ts.NodeSet_Cast(func(func(ts.Node) bool) {}).Count(); got != 0 {
+		t.Errorf("Count of an empty NodeSet: got %d, want 0", got)
+	}
+	decls := all.Kind(ts.KindImportDeclaration, ts.KindFunctionDeclaration, ts.KindClassDeclaration,
+		ts.KindVariableDeclaration, ts.KindMethodDeclaration, ts.KindGetAccessor, ts.KindPropertyDeclaration)
+	counts, err := decls.CountByKind()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[ts.Kind]int{
+		ts.KindImportDeclaration:   1,
+		ts.KindFunctionDeclaration: 2,
+		ts.KindClassDeclaration:    2,
+		ts.KindVariableDeclaration: 2,
+		ts.KindMethodDeclaration:   1,
+		ts.KindGetAccessor:         1,
+		ts.KindPropertyDeclaration: 1,
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("CountByKind:\ngot  %v\nwant %v", counts, want)
+	}
+}
+
+func TestCountError(t *testing.T) {
+	f := parseFixture(t, "sample.ts")
+	bad := ts.NodeSet_Cast(func(yield func(ts.Node) bool) {
+		panic("enumerated a NodeSet with an error")
+	})
+	bad.Err = dql.ErrNotFound
+	if got := bad.Count(); got != 0 {
+		t.Errorf("Count with an error: got %d, want 0", got)
+	}
+	if _, err := bad.CountByKind(); err != dql.ErrNotFound {
+		t.Errorf("CountByKind with an error: got %v, want ErrNotFound", err)
+	}
+	if _, err := ts.New(&f.SourceFile).XGo_Elem("fileName").CountByKind(); err != ts.ErrNotSyntaxNode {
+		t.Errorf("CountByKind of a non-syntax node: got %v, want ErrNotSyntaxNode", err)
+	}
+	// CountByKind stops at the first non-syntax node
+	n := 0
+	mixed := ts.NodeSet_Cast(func(yield func(ts.Node) bool) {
+		for _, v := range []any{f.AsNode(), "x", f.AsNode()} {
+			n++
+			if !yield(ts.Node{Value: reflect.ValueOf(v)}) {
+				return
+			}
+		}
+	})
+	if _, err := mixed.CountByKind(); err != ts.ErrNotSyntaxNode || n != 2 {
+		t.Errorf("CountByKind of a mixed NodeSet: got %v after %d nodes", err, n)
+	}
+}
+
+func TestAncestors(t *testing.T) {
+	all := descendants(parseFixture(t, "config.ts"))
+	ids := all.Filter(func(n *ast.Node) bool {
+		return n.Kind == ast.KindIdentifier && n.Text() == "config"
+	})
+	if got := count(ids); got != 6 {
+		t.Fatalf("identifiers named config: got %d, want 6", got)
+	}
+	// shared ancestors are returned once; the top-level const has none
+	fns := ids.Ancestors(ts.KindFunctionDeclaration, ts.KindArrowFunction)
+	texts, err := fns.Texts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"function init(config: Options) {\n  const timeout = config.timeout;\n  return config.retries + timeout;\n}",
+		"(config: Options) => {\n  return () => config.name;\n}",
+		"() => config.name",
+	}
+	if !slices.Equal(texts, want) {
+		t.Errorf("Ancestors:\ngot  %q\nwant %q", texts, want)
+	}
+}
+
+func TestParent(t *testing.T) {
+	f := parseFixture(t, "config.ts")
+	all := descendants(f)
+	params := all.Kind(ts.KindParameter)
+	if got := kindsOf(params.Parent()); !slices.Equal(got, []ts.Kind{ts.KindFunctionDeclaration, ts.KindArrowFunction}) {
+		t.Errorf("Parent of parameters: got %v", got)
+	}
+	// parents shared by several nodes are returned once
+	if got := count(all.Kind(ts.KindFunctionDeclaration, ts.KindImportDeclaration).Parent()); got != 1 {
+		t.Errorf("Parent of top-level statements: got %d nodes, want 1", got)
+	}
+	if got := count(ts.New(&f.SourceFile).Parent()); got != 0 {
+		t.Errorf("Parent of a source file: got %d nodes, want 0", got)
+	}
+	if got := count(ts.New(&f.SourceFile).XGo_Elem("fileName").Parent()); got != 0 {
+		t.Errorf("Parent of a non-syntax node: got %d nodes, want 0", got)
+	}
+}
+
+func TestKindGroups(t *testing.T) {
+	doc := ts.New(&parseFixture(t, "kinds.ts").SourceFile)
+	// let, for-of, if, continue, expression, return (sum);
+	// try, return, throw (pick)
+	stmts := doc.Statements()
+	if got := stmts.Count(); got != 9 {
+		t.Errorf("Statements: got %d, want 9", got)
+	}
+	if got := doc.AnyKind(ts.KindVariableStatement, ts.KindReturnStatement).Count(); got != 3 {
+		t.Errorf("AnyKind(variable, return statements): got %d, want 3", got)
+	}
+	// [T, T], T, T, (e: Event) => void, Event, Array<number>,
+	// Pair<string> | null, Pair<string>, null, keyof Options, Options
+	types, err := doc.TypeNodes().CountByKind()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[ts.Kind]int{
+		ts.KindTupleType:     1,
+		ts.KindTypeReference: 6,
+		ts.KindFunctionType:  1,
+		ts.KindUnionType:     1,
+		ts.KindLiteralType:   1,
+		ts.KindTypeOperator:  1,
+	}
+	if !reflect.DeepEqual(types, want) {
+		t.Errorf("TypeNodes:\ngot  %v\nwant %v", types, want)
+	}
+	// Statements and TypeNodes are descendant queries on the current set
+	if got := doc.AnyKind(ts.KindFunctionDeclaration).Statements().Count(); got != 9 {
+		t.Errorf("Statements of functions: got %d, want 9", got)
+	}
+}
+
+func TestExpressions(t *testing.T) {
+	doc := ts.From("", "let total = a + f(1);")
+	exprs, err := doc.Expressions().Texts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"total", "a + f(1)", "a", "f(1)", "f", "1"}
+	if !slices.Equal(exprs, want) {
+		t.Errorf("Expressions: got %q, want %q", exprs, want)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"testing"
+
+	"github.com/goplus/dql/ts"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+func TestPos(t *testing.T) {
+	all := descendants(parseFixture(t, "sample.ts"))
+	cases := []struct {
+		kind                   ts.Kind
+		line, col, eline, ecol int
+	}{
+		{ts.KindImportDeclaration, 1, 1, 1, 31},
+		{ts.KindFunctionDeclaration, 3, 1, 5, 2},
+		{ts.KindClassDeclaration, 12, 1, 18, 2},
+		{ts.KindGetAccessor, 21, 3, 23, 4},
+	}
+	for _, c := range cases {
+		ns := all.Kind(c.kind)
+		line, col, err := ns.Pos()
+		if err != nil || line != c.line || col != c.col {
+			t.Errorf("Pos of %v: got %d:%d (%v), want %d:%d", c.kind, line, col, err, c.line, c.col)
+		}
+		line, col, err = ns.End()
+		if err != nil || line != c.eline || col != c.ecol {
+			t.Errorf("End of %v: got %d:%d (%v), want %d:%d", c.kind, line, col, err, c.eline, c.ecol)
+		}
+	}
+}
+
+func TestPosMultiByte(t *testing.T) {
+	// columns are counted in characters, not bytes
+	doc := ts.From("", "const s = \"héllo→\"; let x = 1;\n")
+	x := doc.AnyKind(ts.KindIdentifier).Filter(func(n *ast.Node) bool {
+		return n.Text() == "x"
+	})
+	line, col, err := x.Pos()
+	if err != nil || line != 1 || col != 25 {
+		t.Errorf("Pos: got %d:%d (%v), want 1:25", line, col, err)
+	}
+	line, col, err = x.End()
+	if err != nil || line != 1 || col != 26 {
+		t.Errorf("End: got %d:%d (%v), want 1:26", line, col, err)
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"strings"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// printNode renders the node as TypeScript source, see NodeSet.Print.
+func printNode(n *ast.Node) (string, error) {
+	f := sourceFileOf(n)
+	if f == nil {
+		return "", ErrNoSourceFile
+	}
+	text := f.Text()
+	start := tokenPos(f, n)
+	if docs := n.JSDoc(nil); len(docs) > 0 {
+		// skip whitespace only, the JSDoc node starts with its comment
+		pos := docs[0].Pos()
+		start = len(text) - len(strings.TrimLeft(text[pos:], " \t\r\n"))
+	}
+	lineStart := strings.LastIndexAny(text[:start], "\r\n") + 1
+	indent := text[lineStart:start]
+	ret := text[start:n.End()]
+	if indent == "" || strings.TrimLeft(indent, " \t") != "" {
+		return ret, nil // not the first token of its line
+	}
+	lines := strings.SplitAfter(ret, "\n")
+	for i := 1; i < len(lines); i++ {
+		lines[i] = strings.TrimPrefix(lines[i], indent)
+	}
+	return strings.Join(lines, ""), nil
+}
+
+// Print renders the first node in the NodeSet as TypeScript source.
+//
+// The upstream printer isn't exposed, and the nodes of a parsed file are never
+// modified, so the source is rendered from the original text: it includes the
+// JSDoc comments attached to the node, and the indentation of the line where
+// the node starts is removed from the following lines. The formatting of the
+// original text is kept as is, including other comments inside the node.
+func (p NodeSet) Print() (string, error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return "", err
+	}
+	return printNode(n)
+}
+
+// PrintAll renders all nodes in the NodeSet like Print, separated by newlines.
+func (p NodeSet) PrintAll() (string, error) {
+	if p.Err != nil {
+		return "", p.Err
+	}
+	var b strings.Builder
+	var err error
+	p.Data(func(node Node) bool {
+		n := astNode(node)
+		if n == nil {
+			err = ErrNotSyntaxNode
+			return false
+		}
+		var text string
+		if text, err = printNode(n); err != nil {
+			return false
+		}
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(text)
+		return true
+	})
+	return b.String(), err
+}
+
+// -----------------------------------------------------------------------------
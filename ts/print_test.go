@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+const printSrc = `namespace shapes {
+  /** area returns the area of a rectangle. */
+  export function area(w: number, h: number): number {
+    // no negative sizes
+    return w * h;
+  }
+
+  interface Size {
+    w: number;
+    h: number;
+  }
+}
+`
+
+func TestPrint(t *testing.T) {
+	doc := ts.From("", printSrc)
+	cases := []struct {
+		kind ts.Kind
+		want string
+	}{
+		{ts.KindFunctionDeclaration, `/** area returns the area of a rectangle. */
+export function area(w: number, h: number): number {
+  // no negative sizes
+  return w * h;
+}`},
+		{ts.KindInterfaceDeclaration, `interface Size {
+  w: number;
+  h: number;
+}`},
+		{ts.KindReturnStatement, "return w * h;"},
+	}
+	for _, c := range cases {
+		got, err := doc.AnyKind(c.kind).Print()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Errorf("Print(%v):\ngot  %q\nwant %q", c.kind, got, c.want)
+		}
+		// the output parses back to the same source
+		again, err := ts.From("", got).AnyKind(c.kind).Print()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again != got {
+			t.Errorf("Print(%v) round trip:\ngot  %q\nwant %q", c.kind, again, got)
+		}
+	}
+}
+
+func TestPrintAll(t *testing.T) {
+	doc := ts.From("", printSrc)
+	got, err := doc.AnyKind(ts.KindPropertySignature, ts.KindParameter).PrintAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "w: number\nh: number\nw: number;\nh: number;"; got != want {
+		t.Errorf("PrintAll:\ngot  %q\nwant %q", got, want)
+	}
+	if _, err := doc.XGo_Elem("fileName").PrintAll(); err != ts.ErrNotSyntaxNode {
+		t.Errorf("PrintAll of a non-syntax node: got %v, want ErrNotSyntaxNode", err)
+	}
+}
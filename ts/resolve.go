@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/goplus/xgo/dql"
+)
+
+var (
+	ErrBareSpecifier = errors.New("bare module specifier")
+)
+
+// -----------------------------------------------------------------------------
+
+// ResolveConfig represents the module resolution options of a project, as the
+// baseUrl and paths compiler options of tsconfig.json.
+type ResolveConfig struct {
+	BaseURL string              // slash-separated, relative to the project root
+	Paths   map[string][]string // e.g. {"@/*": ["src/*"]}, relative to BaseURL
+}
+
+// ParseTsconfig reads the baseUrl and paths compiler options from the content
+// of a tsconfig.json file at the project root. Comments and trailing commas are
+// allowed. The extends option is not followed.
+func ParseTsconfig(data []byte) (conf ResolveConfig, err error) {
+	var tsconfig struct {
+		CompilerOptions struct {
+			BaseURL string              `json:"baseUrl"`
+			Paths   map[string][]string `json:"paths"`
+		} `json:"compilerOptions"`
+	}
+	if err = json.Unmarshal(stripJSONC(data), &tsconfig); err != nil {
+		return
+	}
+	opts := tsconfig.CompilerOptions
+	return ResolveConfig{BaseURL: opts.BaseURL, Paths: opts.Paths}, nil
+}
+
+// stripJSONC removes the comments and trailing commas of JSON with comments.
+func stripJSONC(data []byte) []byte {
+	ret := make([]byte, 0, len(data))
+	comma := -1 // position of a pending comma in ret
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '"':
+			start := i
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					i++
+				}
+			}
+			ret = append(ret, data[start:min(i+1, len(data))]...)
+			comma = -1
+			continue
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			continue
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			end := strings.Index(string(data[i+2:]), "*/")
+			if end < 0 {
+				i = len(data)
+			} else {
+				i += end + 3
+			}
+			continue
+		case c == ']' || c == '}':
+			if comma >= 0 {
+				ret[comma] = ' '
+			}
+		case c == ',':
+			ret = append(ret, c)
+			comma = len(ret) - 1
+			continue
+		}
+		if c > ' ' {
+			comma = -1
+		}
+		ret = append(ret, c)
+	}
+	return ret
+}
+
+// -----------------------------------------------------------------------------
+
+// tsExts lists the extensions tried for an extensionless module specifier.
+var tsExts = []string{".ts", ".tsx", ".d.ts"}
+
+// jsExts maps JavaScript extensions to the TypeScript extensions of the files
+// they are emitted from, e.g. import "./util.js" refers to util.ts.
+var jsExts = map[string][]string{
+	".js":  {".ts", ".tsx", ".d.ts"},
+	".jsx": {".tsx"},
+	".mjs": {".mts", ".d.mts"},
+	".cjs": {".cts", ".d.cts"},
+}
+
+// resolveFile resolves the module path p (relative to the project root) to a
+// file of the project, trying the TypeScript extensions and index files.
+func resolveFile(files map[string]bool, p string) (string, bool) {
+	if files[p] {
+		return p, true
+	}
+	if exts, ok := jsExts[path.Ext(p)]; ok {
+		base := strings.TrimSuffix(p, path.Ext(p))
+		for _, ext := range exts {
+			if files[base+ext] {
+				return base + ext, true
+			}
+		}
+	}
+	for _, base := range []string{p, path.Join(p, "index")} {
+		for _, ext := range tsExts {
+			if files[base+ext] {
+				return base + ext, true
+			}
+		}
+	}
+	return "", false
+}
+
+// matchPattern matches the specifier against a paths pattern with at most one
+// "*", returning the text matched by "*" and the length of the text before "*"
+// (the whole pattern if there is no "*").
+func matchPattern(pattern, specifier string) (star string, prefixLen int, ok bool) {
+	prefix, suffix, wildcard := strings.Cut(pattern, "*")
+	if !wildcard {
+		return "", len(pattern), pattern == specifier
+	}
+	if len(specifier) < len(prefix)+len(suffix) ||
+		!strings.HasPrefix(specifier, prefix) || !strings.HasSuffix(specifier, suffix) {
+		return "", 0, false
+	}
+	return specifier[len(prefix) : len(specifier)-len(suffix)], len(prefix), true
+}
+
+// Resolve resolves the module specifier imported by fromFile to a file of the
+// project, like the node module resolution of TypeScript. The project is a
+// NodeSet with one node per file named by its slash-separated path relative to
+// the project root, as returned by FromDir; fromFile and the result are such
+// names.
+//
+// Relative specifiers ("./util", "../lib/index.js") are resolved against the
+// directory of fromFile, trying the exact path, the TypeScript extensions (.ts,
+// .tsx, .d.ts, or the ones corresponding to a .js extension) and index files.
+// Other specifiers are resolved with the paths and baseUrl options if conf is
+// given. ErrBareSpecifier is returned for specifiers left unresolved that
+// aren't relative, such as npm packages, and an error wrapping ErrNotFound for
+// relative specifiers matching no file.
+func Resolve(project NodeSet, fromFile, specifier string, conf ...ResolveConfig) (string, error) {
+	if project.Err != nil {
+		return "", project.Err
+	}
+	files := make(map[string]bool)
+	project.Data(func(node Node) bool {
+		files[node.Name] = true
+		return true
+	})
+	if strings.HasPrefix(specifier, "./") || strings.HasPrefix(specifier, "../") || specifier == "." || specifier == ".." {
+		if ret, ok := resolveFile(files, path.Join(path.Dir(fromFile), specifier)); ok {
+			return ret, nil
+		}
+		return "", fmt.Errorf("cannot resolve %q from %s: %w", specifier, fromFile, dql.ErrNotFound)
+	}
+	if len(conf) > 0 {
+		c := conf[0]
+		best, star, bestLen := "", "", -1
+		for pattern := range c.Paths { // the longest prefix wins
+			if s, n, ok := matchPattern(pattern, specifier); ok {
+				if n > bestLen || n == bestLen && pattern < best {
+					best, star, bestLen = pattern, s, n
+				}
+			}
+		}
+		if bestLen >= 0 {
+			for _, subst := range c.Paths[best] {
+				p := path.Join(c.BaseURL, strings.Replace(subst, "*", star, 1))
+				if ret, ok := resolveFile(files, p); ok {
+					return ret, nil
+				}
+			}
+		}
+		if c.BaseURL != "" {
+			if ret, ok := resolveFile(files, path.Join(c.BaseURL, specifier)); ok {
+				return ret, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("cannot resolve %q from %s: %w", specifier, fromFile, ErrBareSpecifier)
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+	"github.com/goplus/xgo/dql"
+)
+
+func TestResolve(t *testing.T) {
+	dir := filepath.Join("testdata", "proj")
+	project, err := ts.FromDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "tsconfig.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf, err := ts.ParseTsconfig(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		from, specifier, want string
+	}{
+		{"src/index.ts", "./util", "src/util/index.ts"},          // index resolution
+		{"src/index.ts", "./lib/math", "src/lib/math.ts"},        // extensionless
+		{"src/index.ts", "./lib/math.js", "src/lib/math.ts"},     // .js emitted from .ts
+		{"src/util/index.ts", "./format", "src/util/format.tsx"}, // .tsx
+		{"src/util/index.ts", "../types", "src/types.d.ts"},      // .d.ts
+		{"src/util/index.ts", "..", "src/index.ts"},
+		{"src/index.ts", "@lib/math", "src/lib/math.ts"},       // paths alias
+		{"src/index.ts", "util/format", "src/util/format.tsx"}, // baseUrl
+	}
+	for _, c := range cases {
+		got, err := ts.Resolve(project, c.from, c.specifier, conf)
+		if err != nil || got != c.want {
+			t.Errorf("Resolve(%q, %q): got %q (%v), want %q", c.from, c.specifier, got, err, c.want)
+		}
+	}
+	if _, err := ts.Resolve(project, "src/index.ts", "react", conf); !errors.Is(err, ts.ErrBareSpecifier) {
+		t.Errorf("Resolve of a bare specifier: got %v, want ErrBareSpecifier", err)
+	}
+	if _, err := ts.Resolve(project, "src/index.ts", "@lib/math"); !errors.Is(err, ts.ErrBareSpecifier) {
+		t.Errorf("Resolve of an alias without config: got %v, want ErrBareSpecifier", err)
+	}
+	if _, err := ts.Resolve(project, "src/index.ts", "./missing", conf); !errors.Is(err, dql.ErrNotFound) {
+		t.Errorf("Resolve of a missing file: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestResolvePaths(t *testing.T) {
+	project, err := ts.FromDir(filepath.Join("testdata", "proj"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := ts.ResolveConfig{
+		BaseURL: "src",
+		Paths: map[string][]string{
+			"@x/*/index": {"missing/*"},
+			"@x/util/*":  {"util/*"},
+			"@x/*":       {"lib/*"},
+			"@x/exact":   {"types.d.ts"},
+		},
+	}
+	cases := []struct {
+		specifier, want string
+	}{
+		{"@x/util/index", "src/util/index.ts"}, // "@x/util/" is longer than "@x/"
+		{"@x/math", "src/lib/math.ts"},
+		{"@x/exact", "src/types.d.ts"},
+	}
+	for _, c := range cases {
+		got, err := ts.Resolve(project, "src/index.ts", c.specifier, conf)
+		if err != nil || got != c.want {
+			t.Errorf("Resolve(%q): got %q (%v), want %q", c.specifier, got, err, c.want)
+		}
+	}
+}
+
+func TestParseTsconfig(t *testing.T) {
+	conf, err := ts.ParseTsconfig([]byte(`{
+  /* block */ "compilerOptions": {
+    "baseUrl": ".", // line
+    "paths": { "a//b": ["c/*",], "@/*": ["src/*"], },
+  },
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conf.BaseURL != "." || len(conf.Paths) != 2 || conf.Paths["a//b"][0] != "c/*" {
+		t.Errorf("ParseTsconfig: got %+v", conf)
+	}
+	if _, err := ts.ParseTsconfig([]byte(`{"compilerOptions": `)); err == nil {
+		t.Error("ParseTsconfig of invalid JSON: no error")
+	}
+}
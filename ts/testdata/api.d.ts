@@ -0,0 +1,29 @@
+export interface Box<T extends object = {}> extends Iterable<T> {
+  readonly value: T;
+  label?: string;
+  map<U extends object>(fn: (v: T) => U): Box<U>;
+}
+
+export declare function parse(text: string): number;
+export declare function parse(text: string, radix?: number, ...rest: any[]): number;
+
+export type Id = string | number;
+
+export declare const enum Color {
+  Red,
+  Green = 2,
+}
+
+export declare class Shape {
+  private secret;
+  constructor(name: string);
+  static create(): Shape;
+  get area(): number;
+}
+
+export declare namespace Geo.Units {
+  const meter: number;
+  namespace Inner {
+    function convert(x: number): number;
+  }
+}
@@ -0,0 +1,16 @@
+import * as UI from "./ui";
+
+export function App(props: { title: string }) {
+  return (
+    <>
+      <Header title={props.title} />
+      <Panel kind="main" {...props}>
+        <Button disabled onClick={() => alert("hi")}>OK</Button>
+        <UI.Button label="Cancel" />
+        <Panel kind="nested">
+          <Button>Retry</Button>
+        </Panel>
+      </Panel>
+    </>
+  );
+}
@@ -0,0 +1,16 @@
+import { load } from "./load";
+
+function init(config: Options) {
+  const timeout = config.timeout;
+  return config.retries + timeout;
+}
+
+export const setup = (config: Options) => {
+  return () => config.name;
+};
+
+function unrelated() {
+  return load();
+}
+
+const config = load();
@@ -0,0 +1,20 @@
+@Component({ selector: "app-root", standalone: true })
+export class AppComponent {
+  @Input() title: string;
+  @Input name: string;
+  count = 0;
+
+  constructor(@Inject(TOKEN) private readonly svc: Service, plain: number) {}
+
+  @HostListener("click", ["$event"])
+  onClick(e: Event) {}
+
+  @ng.Output() changed: Emitter;
+}
+
+@Injectable
+export class Service {}
+
+class Plain {
+  run() {}
+}
@@ -0,0 +1,35 @@
+export enum Direction {
+  Up,
+  Down,
+  Left = 10,
+  Right,
+}
+
+export const enum Flags {
+  None = 0,
+  Read = 1 << 0,
+  Write = 1 << 1,
+  ReadWrite = Read | Write,
+  Half = 1 / 2,
+}
+
+enum Color {
+  Red = "RED",
+  Green = "GREEN",
+  Blue = `BLUE`,
+}
+
+enum Mixed {
+  A = 1,
+  Len = "abc".length,
+  After,
+  B = Mixed.A + 1,
+}
+
+const port = 8080;
+const greeting = `hello ${"world"}`;
+const enabled = true;
+const ratio = -1.5;
+const now = Date.now();
+let mutable = 1;
+const a = 1, b = 2;
@@ -0,0 +1,11 @@
+export class Store {}
+export const version = "1.0";
+export default function main() {}
+
+function helper() {}
+const limit = 10, internal = 0;
+export { helper, limit as maxItems };
+
+function hidden() {}
+let counter = 0;
+for (const item of []) {}
@@ -0,0 +1,41 @@
+/**
+ * Adds two numbers.
+ *
+ * @param a the first operand
+ * @param b the second operand
+ * @returns the sum
+ */
+export function add(a: number, b: number): number {
+  return a + b;
+}
+
+/**
+ * Subtracts b from a.
+ * @deprecated use {@link add} with a negative operand
+ */
+export function sub(a: number, b: number): number {
+  return a - b;
+}
+
+// A line comment is not JSDoc.
+export function mul(a: number, b: number): number {
+  return a * b;
+}
+
+/** The default timeout in milliseconds. */
+export const timeout = 1000;
+
+/** Both limits, in bytes. */
+export const minSize = 1, maxSize = 1 << 20;
+
+/** Loads the configuration. */
+export function load(): void {
+  try {
+    /** The raw configuration. */
+    const raw = JSON.parse("{}");
+  } catch (err) {
+    console.error(err);
+  }
+  for (const key of Object.keys({})) {
+  }
+}
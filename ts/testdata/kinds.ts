@@ -0,0 +1,19 @@
+type Pair<T> = [T, T];
+type Handler = (e: Event) => void;
+
+function sum(xs: Array<number>): number {
+  let total = 0;
+  for (const x of xs) {
+    if (x < 0) continue;
+    total += x;
+  }
+  return total;
+}
+
+function pick(p: Pair<string> | null, key: keyof Options): string {
+  try {
+    return p![0];
+  } catch {
+    throw new Error("no pair");
+  }
+}
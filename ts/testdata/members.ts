@@ -0,0 +1,28 @@
+export class Counter {
+  static #instances = 0;
+  private static readonly registry = new Map<string, Counter>();
+  private value = 0;
+
+  constructor(start?: number) {}
+
+  static create(): Counter;
+  static create(start: number): Counter;
+  static create(start?: number): Counter {
+    return new Counter(start);
+  }
+
+  get count(): number {
+    return this.value;
+  }
+  set count(v: number) {
+    this.value = v;
+  }
+
+  "reset-all"() {}
+  [Symbol.iterator]() {}
+}
+
+interface Shape {
+  readonly sides: number;
+  area(): number;
+}
@@ -0,0 +1,17 @@
+import React from "react";
+import * as path from "path";
+import { readFile, writeFile as write, type Stats } from "fs";
+import type { Config } from "./config";
+import Default, { named } from "./both";
+import "./polyfill";
+import fs = require("fs");
+
+export const version = "1.0", build = 42;
+export function helper() {}
+export default class App {}
+class Internal {}
+export { Internal as Impl };
+export type { Config };
+export * from "./util";
+export * as ns from "./ns";
+export { a, b as c } from "./re";
@@ -0,0 +1 @@
+export const cached = 1;
@@ -0,0 +1,7 @@
+import { format } from "./util";
+import { add } from "./lib/math.js";
+import { sub } from "@lib/math";
+import React from "react";
+
+export const answer = add(40, 2) - sub(1, 1);
+export { format, React };
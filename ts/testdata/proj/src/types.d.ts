@@ -0,0 +1 @@
+declare const VERSION: string;
@@ -0,0 +1,28 @@
+import { readFile } from "fs";
+
+export function load(path: string): Promise<string> {
+  return readFile(path, "utf8");
+}
+
+function parse(text: string) {
+  const lines = text.split("\n");
+  return lines.map((line) => line.trim());
+}
+
+export class Store {
+  private items: string[] = [];
+
+  add(item: string): void {
+    this.items.push(item);
+  }
+}
+
+class Cache extends Store {
+  get size(): number {
+    return 0;
+  }
+}
+
+const handler = function () {
+  console.log(parse("a\nb"));
+};
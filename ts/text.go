@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// sourceFileOf returns the source file containing the given node by following
+// its parent links. It returns nil if the node is detached from any file.
+func sourceFileOf(n *ast.Node) *ast.SourceFile {
+	for ; n != nil; n = n.Parent {
+		if n.Kind == ast.KindSourceFile {
+			return n.AsSourceFile()
+		}
+	}
+	return nil
+}
+
+// skipTrivia returns the position of the first token at or after pos, skipping
+// whitespace, line breaks, comments and a leading shebang line.
+func skipTrivia(text string, pos int) int {
+	if pos == 0 && strings.HasPrefix(text, "#!") {
+		pos = lineEnd(text, 0)
+	}
+	for pos < len(text) {
+		switch c := text[pos]; c {
+		case ' ', '\t', '\n', '\r', '\v', '\f':
+			pos++
+		case '/':
+			if pos+1 < len(text) {
+				switch text[pos+1] {
+				case '/':
+					pos = lineEnd(text, pos+2)
+					continue
+				case '*':
+					if end := strings.Index(text[pos+2:], "*/"); end >= 0 {
+						pos += end + 4
+					} else {
+						pos = len(text)
+					}
+					continue
+				}
+			}
+			return pos
+		default:
+			if c < utf8.RuneSelf {
+				return pos
+			}
+			r, size := utf8.DecodeRuneInString(text[pos:])
+			if !unicode.IsSpace(r) && r != 0xfeff { // BOM is whitespace in TypeScript
+				return pos
+			}
+			pos += size
+		}
+	}
+	return pos
+}
+
+// lineEnd returns the position of the line break ending the line containing
+// pos, or len(text) if it's the last line.
+func lineEnd(text string, pos int) int {
+	if i := strings.IndexAny(text[pos:], "\r\n"); i >= 0 {
+		return pos + i
+	}
+	return len(text)
+}
+
+// tokenPos returns the start position of the node, skipping leading trivia.
+func tokenPos(f *ast.SourceFile, n *ast.Node) int {
+	pos := n.Pos()
+	if n.Kind == ast.KindJsxText || n.Kind == ast.KindSourceFile || n.End() <= pos {
+		return pos // whitespace is part of JsxText, missing nodes have no width
+	}
+	return skipTrivia(f.Text(), pos)
+}
+
+// nodeText returns the source text of the node. If fullStart is true, the
+// leading trivia (whitespace and comments) is included.
+func nodeText(n *ast.Node, fullStart bool) (string, error) {
+	f := sourceFileOf(n)
+	if f == nil {
+		return "", ErrNoSourceFile
+	}
+	pos := n.Pos()
+	if !fullStart {
+		pos = tokenPos(f, n)
+	}
+	return f.Text()[pos:n.End()], nil
+}
+
+// lineAndColumn returns the 1-based line and column of the given position.
+// Like the upstream scanner, the column is counted in runes.
+func lineAndColumn(f *ast.SourceFile, pos int) (line, col int) {
+	lines := f.ECMALineMap()
+	i := sort.Search(len(lines), func(i int) bool { return int(lines[i]) > pos }) - 1
+	start := int(lines[i])
+	return i + 1, utf8.RuneCountInString(f.Text()[start:pos]) + 1
+}
+
+// errorAt returns an error with the given message, prefixed with the position
+// of the node if it's known.
+func errorAt(n *ast.Node, msg string) error {
+	if n != nil {
+		if f := sourceFileOf(n); f != nil {
+			line, col := lineAndColumn(f, tokenPos(f, n))
+			return fmt.Errorf("%s:%d:%d: %s", f.FileName(), line, col, msg)
+		}
+	}
+	return errors.New(msg)
+}
+
+// -----------------------------------------------------------------------------
+
+// Text returns the source text of the first node in the NodeSet, without its
+// leading trivia (whitespace and comments).
+func (p NodeSet) Text() (val string, err error) {
+	n, err := p.firstAST()
+	if err == nil {
+		val, err = nodeText(n, false)
+	}
+	return
+}
+
+// FullText returns the source text of the first node in the NodeSet, starting
+// from its full start, that is, including the leading trivia.
+func (p NodeSet) FullText() (val string, err error) {
+	n, err := p.firstAST()
+	if err == nil {
+		val, err = nodeText(n, true)
+	}
+	return
+}
+
+// Texts returns the source text of all nodes in the NodeSet, without their
+// leading trivia.
+func (p NodeSet) Texts() (ret []string, err error) {
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	p.Data(func(node Node) bool {
+		n := astNode(node)
+		if n == nil {
+			err = ErrNotSyntaxNode
+			return false
+		}
+		var text string
+		if text, err = nodeText(n, false); err != nil {
+			return false
+		}
+		ret = append(ret, text)
+		return true
+	})
+	return
+}
+
+// -----------------------------------------------------------------------------
+
+// Pos returns the 1-based line and column where the first node in the NodeSet
+// starts, not counting its leading trivia.
+func (p NodeSet) Pos() (line, col int, err error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return
+	}
+	f := sourceFileOf(n)
+	if f == nil {
+		return 0, 0, ErrNoSourceFile
+	}
+	line, col = lineAndColumn(f, tokenPos(f, n))
+	return
+}
+
+// End returns the 1-based line and column immediately after the first node in
+// the NodeSet.
+func (p NodeSet) End() (line, col int, err error) {
+	n, err := p.firstAST()
+	if err != nil {
+		return
+	}
+	f := sourceFileOf(n)
+	if f == nil {
+		return 0, 0, ErrNoSourceFile
+	}
+	line, col = lineAndColumn(f, n.End())
+	return
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+const classSrc = `// Point is a 2D point.
+export class Point {
+  constructor(public x: number, public y: number) {}
+}
+`
+
+func TestText(t *testing.T) {
+	doc := ts.From("", classSrc)
+	cls := doc.AnyKind(ts.KindClassDeclaration)
+	text, err := cls.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := classSrc[len("// Point is a 2D point.\n") : len(classSrc)-1]; text != want {
+		t.Errorf("Text: got %q, want %q", text, want)
+	}
+	full, err := cls.FullText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := classSrc[:len(classSrc)-1]; full != want {
+		t.Errorf("FullText: got %q, want %q", full, want)
+	}
+	texts, err := doc.AnyKind(ts.KindParameter).Texts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"public x: number", "public y: number"}; !slices.Equal(texts, want) {
+		t.Errorf("Texts: got %q, want %q", texts, want)
+	}
+}
+
+func TestTextError(t *testing.T) {
+	doc := ts.From("", classSrc)
+	if _, err := doc.AnyKind(ts.KindInterfaceDeclaration).Text(); err == nil {
+		t.Error("Text of an empty NodeSet: no error")
+	}
+	if _, err := doc.XGo_Elem("fileName").Text(); err != ts.ErrNotSyntaxNode {
+		t.Errorf("Text of a non-syntax node: got %v, want ErrNotSyntaxNode", err)
+	}
+}
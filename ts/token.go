@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"iter"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// Token represents a token of a TypeScript file.
+type Token struct {
+	Kind   Kind   // e.g. KindIdentifier, KindOpenBraceToken, KindSingleLineCommentTrivia
+	Text   string // source text of the token
+	Pos    int    // byte offset of the token
+	Line   int    // 1-based line
+	Column int    // 1-based column, counted in runes
+}
+
+// Tokens parses TypeScript source code from the given filename or source, and
+// returns the token stream of the file. If Config.Trivia is set, whitespace,
+// line breaks and comments are included as trivia tokens.
+//
+// The upstream scanner isn't exposed, so the tokens are recovered from the
+// syntax tree: names, literals, template parts and JSX text are its leaves,
+// and only the text between them is scanned. Hence contextual tokens such as
+// regular expressions and templates are told apart the way the parser did.
+// The same goes for `>`: operators such as `>>` and `>=` are single tokens in
+// expressions (`a >> b`), as rescanned by the parser, while the `>>` closing
+// nested type arguments (Array<Array<T>>) yields two KindGreaterThanToken
+// tokens.
+func Tokens(filename string, src any, conf ...Config) (iter.Seq[Token], error) {
+	f, err := parse(filename, src, conf...)
+	if err != nil {
+		return nil, err
+	}
+	return tokens(f, len(conf) > 0 && conf[0].Trivia), nil
+}
+
+// tokens returns the token stream of the given file.
+func tokens(f *ast.SourceFile, trivia bool) iter.Seq[Token] {
+	return func(yield func(Token) bool) {
+		t := tokenizer{f: f, text: f.Text(), trivia: trivia, yield: yield}
+		if t.leaves(f.AsNode()) {
+			t.gap(len(t.text))
+		}
+	}
+}
+
+type tokenizer struct {
+	f      *ast.SourceFile
+	text   string
+	pos    int // end of the last token
+	trivia bool
+	yield  func(Token) bool
+}
+
+// leaves yields the tokens of n and its descendants. It returns false if
+// yield returns false.
+func (t *tokenizer) leaves(n *ast.Node) bool {
+	isLeaf := true
+	stopped := n.ForEachChild(func(child *ast.Node) bool {
+		isLeaf = false
+		return !t.leaves(child)
+	})
+	if stopped {
+		return false
+	}
+	if !isLeaf || n.Kind > ast.KindLastToken || n.Kind == ast.KindEndOfFile {
+		return true
+	}
+	pos := tokenPos(t.f, n)
+	if pos >= n.End() {
+		return true // missing nodes have no width
+	}
+	return t.gap(pos) && t.emit(n.Kind, pos, n.End())
+}
+
+// gap yields the tokens between the last token and end, which are keywords,
+// punctuation and trivia.
+func (t *tokenizer) gap(end int) bool {
+	text := t.text[:end]
+	for pos := t.pos; pos < end; pos = t.pos {
+		kind, n := ast.KindUnknown, 1
+		switch c := text[pos]; {
+		case pos == 0 && strings.HasPrefix(text, "#!"):
+			kind, n = ast.KindSingleLineCommentTrivia, lineEnd(text, 0)
+		case c == '\r' || c == '\n':
+			kind = ast.KindNewLineTrivia
+			if strings.HasPrefix(text[pos:], "\r\n") {
+				n = 2
+			}
+		case strings.HasPrefix(text[pos:], "//"):
+			kind, n = ast.KindSingleLineCommentTrivia, lineEnd(text, pos)-pos
+		case strings.HasPrefix(text[pos:], "/*"):
+			kind, n = ast.KindMultiLineCommentTrivia, len(text)-pos
+			if i := strings.Index(text[pos+2:], "*/"); i >= 0 {
+				n = i + 4
+			}
+		case isSpace(text[pos:]):
+			kind, n = ast.KindWhitespaceTrivia, 0
+			for pos+n < len(text) && text[pos+n] != '\r' && text[pos+n] != '\n' && isSpace(text[pos+n:]) {
+				_, size := utf8.DecodeRuneInString(text[pos+n:])
+				n += size
+			}
+		case isWordChar(text[pos:]):
+			n = 0
+			for pos+n < len(text) && isWordChar(text[pos+n:]) {
+				_, size := utf8.DecodeRuneInString(text[pos+n:])
+				n += size
+			}
+			if kind = StringToToken(text[pos : pos+n]); kind == ast.KindUnknown {
+				kind = ast.KindIdentifier
+			}
+		case c == '>':
+			kind = ast.KindGreaterThanToken
+		default:
+			for i := min(4, len(text)-pos); i > 0; i-- {
+				if k := StringToToken(text[pos : pos+i]); k != ast.KindUnknown {
+					kind, n = k, i
+					break
+				}
+			}
+			if kind == ast.KindUnknown {
+				_, n = utf8.DecodeRuneInString(text[pos:])
+			}
+		}
+		if !t.emit(kind, pos, pos+n) {
+			return false
+		}
+	}
+	return true
+}
+
+// emit yields the token [pos, end), unless it's trivia and trivia is off.
+func (t *tokenizer) emit(kind Kind, pos, end int) bool {
+	t.pos = end
+	if !t.trivia && kind >= ast.KindFirstTriviaToken && kind <= ast.KindLastTriviaToken {
+		return true
+	}
+	line, col := lineAndColumn(t.f, pos)
+	return t.yield(Token{Kind: kind, Text: t.text[pos:end], Pos: pos, Line: line, Column: col})
+}
+
+// isSpace reports whether s starts with a whitespace character other than a
+// line break. The BOM is whitespace in TypeScript.
+func isSpace(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r != '\r' && r != '\n' && (unicode.IsSpace(r) || r == 0xfeff)
+}
+
+// isWordChar reports whether s starts with a character of an identifier or
+// keyword.
+func isWordChar(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+func tokensOf(t *testing.T, src string, conf ...ts.Config) (ret []ts.Token) {
+	t.Helper()
+	seq, err := ts.Tokens("", src, conf...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for tok := range seq {
+		ret = append(ret, tok)
+	}
+	return
+}
+
+func TestTokens(t *testing.T) {
+	got := tokensOf(t, "let x = /a+/g; // re\nf(`v${x}`);")
+	want := []ts.Token{
+		{ts.KindLetKeyword, "let", 0, 1, 1},
+		{ts.KindIdentifier, "x", 4, 1, 5},
+		{ts.KindEqualsToken, "=", 6, 1, 7},
+		{ts.KindRegularExpressionLiteral, "/a+/g", 8, 1, 9},
+		{ts.KindSemicolonToken, ";", 13, 1, 14},
+		{ts.KindIdentifier, "f", 21, 2, 1},
+		{ts.KindOpenParenToken, "(", 22, 2, 2},
+		{ts.KindTemplateHead, "`v${", 23, 2, 3},
+		{ts.KindIdentifier, "x", 27, 2, 7},
+		{ts.KindTemplateTail, "}`", 28, 2, 8},
+		{ts.KindCloseParenToken, ")", 30, 2, 10},
+		{ts.KindSemicolonToken, ";", 31, 2, 11},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokens:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+func TestTokensTrivia(t *testing.T) {
+	got := tokensOf(t, "/* a */ x; // TODO\n", ts.Config{Trivia: true})
+	want := []ts.Token{
+		{ts.KindMultiLineCommentTrivia, "/* a */", 0, 1, 1},
+		{ts.KindWhitespaceTrivia, " ", 7, 1, 8},
+		{ts.KindIdentifier, "x", 8, 1, 9},
+		{ts.KindSemicolonToken, ";", 9, 1, 10},
+		{ts.KindWhitespaceTrivia, " ", 10, 1, 11},
+		{ts.KindSingleLineCommentTrivia, "// TODO", 11, 1, 12},
+		{ts.KindNewLineTrivia, "\n", 18, 1, 19},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokens:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+func TestTokensGreaterThan(t *testing.T) {
+	var got []string
+	for _, tok := range tokensOf(t, "let a: Array<Array<T>> = b >> c; d >= e;") {
+		switch tok.Kind {
+		case ts.KindGreaterThanToken, ts.KindGreaterThanGreaterThanToken, ts.KindGreaterThanEqualsToken:
+			got = append(got, tok.Text)
+		}
+	}
+	if want := []string{">", ">", ">>", ">="}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokens: got %q, want %q", got, want)
+	}
+}
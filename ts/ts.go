@@ -77,10 +77,39 @@ func New(f *ast.SourceFile) NodeSet {
 }
 
 // Config represents the configuration for parsing TypeScript source code.
+//
+// If ScriptKind is not set, it's inferred from the file name extension, and
+// defaults to TypeScript for unknown extensions (e.g. "tsconfig"). Jsx makes
+// the source parsed as TSX regardless of the file name, which is useful for
+// anonymous sources (they are named /index.ts by default, or /index.tsx if Jsx
+// is set). Jsx is ignored if ScriptKind is set.
+//
+// Trivia makes Tokens include whitespace, line breaks and comments.
+//
+// The parser recovers from syntax errors. If FailOnError is set, parsing fails
+// with the error diagnostics of the file instead (see File.Diagnostics).
+//
+// Exclude lists the files and directories skipped by ParseDir and FromDir, in
+// addition to node_modules and hidden directories. A pattern containing "/" is
+// matched against the slash-separated path relative to the directory, others
+// against the base name at any depth, in the syntax of path.Match: "dist",
+// "*.test.ts" and "src/generated" are valid patterns.
 type Config struct {
 	ExternalModuleIndicatorOptions ast.ExternalModuleIndicatorOptions
 	ScriptKind                     core.ScriptKind
 	IgnoreCase                     bool
+	Jsx                            bool
+	Trivia                         bool
+	FailOnError                    bool
+	Exclude                        []string
+}
+
+// defaultFileName returns the file name of anonymous sources.
+func (c *Config) defaultFileName() string {
+	if c.Jsx {
+		return "/index.tsx"
+	}
+	return "/index.ts"
 }
 
 var (
@@ -99,25 +128,47 @@ func parse(filename string, src any, conf ...Config) (f *ast.SourceFile, err err
 	if err != nil {
 		return
 	}
-	if filename == "" { // allow empty filename
-		filename = "/index.ts"
-	} else {
-		filename = tspath.GetNormalizedAbsolutePath(filename, getWd())
-	}
 	var c Config
 	if len(conf) > 0 {
 		c = conf[0]
 	}
-	if c.ScriptKind == 0 {
-		c.ScriptKind = core.GetScriptKindFromFileName(filename)
+	if filename == "" { // allow empty filename
+		filename = c.defaultFileName()
+	} else {
+		filename = tspath.GetNormalizedAbsolutePath(filename, getWd())
 	}
-	opts := ast.SourceFileParseOptions{
+	return parseSource(filename, b, c)
+}
+
+// parseOptions returns the parse options of the given absolute filename.
+func parseOptions(filename string, c Config) ast.SourceFileParseOptions {
+	return ast.SourceFileParseOptions{
 		FileName:                       filename,
 		Path:                           tspath.ToPath(filename, "/", !c.IgnoreCase),
 		ExternalModuleIndicatorOptions: c.ExternalModuleIndicatorOptions,
 	}
+}
+
+// parseSource parses TypeScript source code of the given absolute filename.
+func parseSource(filename string, b []byte, c Config) (f *ast.SourceFile, err error) {
+	if c.ScriptKind == 0 {
+		if c.Jsx {
+			c.ScriptKind = core.GetScriptKindFromFileName(".tsx")
+		} else {
+			c.ScriptKind = core.GetScriptKindFromFileName(filename)
+		}
+		if c.ScriptKind == 0 { // unknown extension
+			c.ScriptKind = core.GetScriptKindFromFileName(".ts")
+		}
+	}
 	sourceText := unsafe.String(unsafe.SliceData(b), len(b))
-	return parser.ParseSourceFile(opts, sourceText, c.ScriptKind), nil
+	f = parser.ParseSourceFile(parseOptions(filename, c), sourceText, c.ScriptKind)
+	if c.FailOnError {
+		if err = syntaxError(f); err != nil {
+			return nil, err
+		}
+	}
+	return
 }
 
 // From parses TypeScript source code from the given filename or source, returning a NodeSet.
@@ -136,6 +187,7 @@ func From(filename string, src any, conf ...Config) NodeSet {
 // - []byte: treated as TypeScript source code.
 // - *bytes.Buffer: treated as TypeScript source code.
 // - io.Reader: treated as TypeScript source code.
+// - FSPath: reads TypeScript source code from the named file of a file system (see FromFS).
 // - *ast.SourceFile: creates a NodeSet from the provided *ast.SourceFile.
 // - reflect.Value: creates a NodeSet from the provided reflect.Value (expected to be *ast.SourceFile).
 // - Node: creates a NodeSet containing the single provided node.
@@ -152,6 +204,8 @@ func Source(r any, conf ...Config) (ret NodeSet) {
 		return From("", v, conf...)
 	case io.Reader:
 		return From("", v, conf...)
+	case FSPath:
+		return FromFS(v.FS, v.Name, conf...)
 	case *ast.SourceFile:
 		return New(v)
 	case reflect.Value:
@@ -200,22 +254,34 @@ func (p NodeSet) XGo_Elem(name string) NodeSet {
 }
 
 // XGo_Child returns a NodeSet containing all child nodes of the nodes in the NodeSet.
+// The children are the same as with reflects, e.g. the exported fields of a
+// syntax node, but the fields of each type are looked up only once.
 func (p NodeSet) XGo_Child() NodeSet {
-	return NodeSet{
-		NodeSet: p.NodeSet.XGo_Child(),
+	if p.Err != nil {
+		return p
 	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			return yieldElems(node, yield)
+		})
+	})
 }
 
 // XGo_Any returns a NodeSet containing all descendant nodes (including the
-// nodes themselves) with the specified name.
+// nodes themselves) with the specified name. Children are found like XGo_Child.
 // If name is "", it returns all nodes.
 //   - .**.name
 //   - .**.“element-name”
 //   - .**.*
 func (p NodeSet) XGo_Any(name string) NodeSet {
-	return NodeSet{
-		NodeSet: p.NodeSet.XGo_Any(name),
+	if p.Err != nil {
+		return p
 	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			return yieldAnyElems(name, node, yield)
+		})
+	})
 }
 
 // -----------------------------------------------------------------------------
@@ -264,28 +330,30 @@ func (p NodeSet) XGo_Attr__0(name string) any {
 
 // XGo_Attr returns the value of the specified attribute from the first node in the
 // NodeSet. It only retrieves the attribute from the first node.
+// If the attribute is a name or literal node, its Go value is returned instead
+// of the node: a string for identifiers, private identifiers (#name), string and
+// numeric literals, and a bool for true/false keywords. A computed property name
+// returns the source text of its expression, e.g. "Symbol.iterator" for
+// [Symbol.iterator]. This applies to both *ast.Node values and concrete node
+// types such as *ast.Identifier, so $name returns a string for all declarations
+// with a name.
+//
+// $kind is the reflected Kind field of the node, and $kindName is synthesized to
+// return the name of its syntax kind (e.g. "KindIdentifier").
 //   - $name
 //   - $“attr-name”
 func (p NodeSet) XGo_Attr__1(name string) (val any, err error) {
 	val, err = p.NodeSet.XGo_Attr__1(name)
 	if err == nil {
-		switch v := val.(type) {
-		case *ast.DeclarationName:
-			if v != nil {
-				switch v.Kind {
-				case ast.KindIdentifier:
-					return v.AsIdentifier().Text, nil
-				case ast.KindPrivateIdentifier:
-					return v.AsPrivateIdentifier().Text, nil
-				case ast.KindStringLiteral:
-					return v.AsStringLiteral().Text, nil
-				case ast.KindNumericLiteral:
-					return v.AsNumericLiteral().Text, nil
-				case ast.KindBigIntLiteral:
-					return v.AsBigIntLiteral().Text, nil
-				}
+		if n := astNode(Node{Value: reflect.ValueOf(val)}); n != nil {
+			if v, ok := nodeValue(n); ok {
+				return v, nil
 			}
 		}
+	} else if name == "kindName" {
+		if n, e := p.firstAST(); e == nil {
+			return n.Kind.String(), nil
+		}
 	}
 	return
 }
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"testing"
+
+	"github.com/goplus/dql/ts"
+	"github.com/microsoft/typescript-go/core"
+)
+
+func TestSourceJsx(t *testing.T) {
+	src := []byte("const el = <Button kind=\"ok\">Hi</Button>;\n")
+	cases := []struct {
+		conf     ts.Config
+		fileName string
+		elems    int
+	}{
+		{ts.Config{}, "/index.ts", 0},
+		{ts.Config{Jsx: true}, "/index.tsx", 1},
+	}
+	for _, c := range cases {
+		doc := ts.Source(src, c.conf)
+		if name := doc.XGo_Attr__0("fileName"); name != c.fileName {
+			t.Errorf("Jsx=%v: got file name %v, want %s", c.conf.Jsx, name, c.fileName)
+		}
+		if got := count(doc.JsxElements("Button")); got != c.elems {
+			t.Errorf("Jsx=%v: got %d JSX elements, want %d", c.conf.Jsx, got, c.elems)
+		}
+	}
+}
+
+func TestSourceJsxScriptKind(t *testing.T) {
+	// ScriptKind takes precedence over Jsx
+	doc := ts.Source([]byte("let x = <T>y;"), ts.Config{Jsx: true, ScriptKind: core.GetScriptKindFromFileName(".ts")})
+	if got := count(doc.AnyKind(ts.KindTypeAssertionExpression)); got != 1 {
+		t.Errorf("got %d type assertions, want 1", got)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"crypto/sha256"
+	"errors"
+	"slices"
+	"sync"
+	"unsafe"
+
+	"github.com/microsoft/typescript-go/ast"
+	"github.com/microsoft/typescript-go/core"
+)
+
+var (
+	ErrInvalidChange = errors.New("text change doesn't match the new text")
+)
+
+// -----------------------------------------------------------------------------
+
+// TextChangeRange describes an edit of a file: the span [Start, Start+Length)
+// of the old text is replaced by NewLength bytes. Offsets are in bytes.
+type TextChangeRange struct {
+	Start     int
+	Length    int
+	NewLength int
+}
+
+// updateCacheSize is the number of files kept by the cache of Update.
+const updateCacheSize = 16
+
+// updateKey identifies the text of a file and the options it's parsed with.
+type updateKey struct {
+	opts ast.SourceFileParseOptions
+	kind core.ScriptKind
+	hash [sha256.Size]byte
+}
+
+func updateKeyOf(opts ast.SourceFileParseOptions, kind core.ScriptKind, text string) updateKey {
+	return updateKey{opts, kind, sha256.Sum256(unsafe.Slice(unsafe.StringData(text), len(text)))}
+}
+
+// updateCache holds the files recently passed to or returned by Update, the
+// most recently used last.
+var updateCache struct {
+	sync.Mutex
+	keys  []updateKey
+	files []*ast.SourceFile
+}
+
+// cachedFile returns the cached file of the given key, or nil if there is none.
+func cachedFile(key updateKey, text string) *ast.SourceFile {
+	updateCache.Lock()
+	defer updateCache.Unlock()
+	i := slices.Index(updateCache.keys, key)
+	if i < 0 || updateCache.files[i].Text() != text {
+		return nil
+	}
+	f := updateCache.files[i]
+	updateCache.keys = append(slices.Delete(updateCache.keys, i, i+1), key)
+	updateCache.files = append(slices.Delete(updateCache.files, i, i+1), f)
+	return f
+}
+
+// cacheFile adds the file to the cache, replacing the file of the same key or
+// evicting the least recently used file if the cache is full.
+func cacheFile(key updateKey, f *ast.SourceFile) {
+	updateCache.Lock()
+	defer updateCache.Unlock()
+	if i := slices.Index(updateCache.keys, key); i >= 0 {
+		updateCache.keys = slices.Delete(updateCache.keys, i, i+1)
+		updateCache.files = slices.Delete(updateCache.files, i, i+1)
+	} else if len(updateCache.keys) == updateCacheSize {
+		updateCache.keys = slices.Delete(updateCache.keys, 0, 1)
+		updateCache.files = slices.Delete(updateCache.files, 0, 1)
+	}
+	updateCache.keys = append(updateCache.keys, key)
+	updateCache.files = append(updateCache.files, f)
+}
+
+// Update returns the File of newText, which is the text of f after the given
+// change. The change is checked against both texts, and ErrInvalidChange is
+// returned if they don't match. The file keeps the name, script kind and
+// module detection options of f, and f itself is left untouched. An optional
+// Config can be provided for the other parsing options, such as FailOnError,
+// which applies to the returned File whether it's parsed or reused.
+//
+// The upstream parser doesn't expose incremental parsing, so a new text is
+// parsed in full. Instead, Update caches the last files passed to or returned
+// by it by content hash: an unchanged text returns f itself, and going back to
+// a recent text (e.g. undoing an edit) returns the File parsed for it before.
+func (f *File) Update(newText string, change TextChangeRange, conf ...Config) (*File, error) {
+	oldText := f.Text()
+	start, oldEnd, newEnd := change.Start, change.Start+change.Length, change.Start+change.NewLength
+	if start < 0 || change.Length < 0 || change.NewLength < 0 || oldEnd > len(oldText) ||
+		len(newText)-newEnd != len(oldText)-oldEnd ||
+		newText[:start] != oldText[:start] || newText[newEnd:] != oldText[oldEnd:] {
+		return nil, ErrInvalidChange
+	}
+	var c Config
+	if len(conf) > 0 {
+		c = conf[0]
+	}
+	c.ScriptKind = f.ScriptKind
+	c.ExternalModuleIndicatorOptions = f.ParseOptions().ExternalModuleIndicatorOptions
+	cacheFile(updateKeyOf(f.ParseOptions(), f.ScriptKind, oldText), &f.SourceFile)
+
+	opts := parseOptions(f.FileName(), c)
+	key := updateKeyOf(opts, c.ScriptKind, newText)
+	doc := cachedFile(key, newText)
+	if doc == nil {
+		b := unsafe.Slice(unsafe.StringData(newText), len(newText)) // read only
+		strict := c.FailOnError
+		c.FailOnError = false
+		doc, _ = parseSource(f.FileName(), b, c)
+		cacheFile(key, doc)
+		c.FailOnError = strict
+	}
+	if c.FailOnError {
+		if err := syntaxError(doc); err != nil {
+			return nil, err
+		}
+	}
+	return (*File)(unsafe.Pointer(doc)), nil
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+)
+
+// largeSource returns a source of several thousand lines, made of n copies of
+// testdata/sample.ts.
+func largeSource(tb testing.TB, n int) string {
+	tb.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", "sample.ts"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return strings.Repeat(string(b), n)
+}
+
+// edit replaces the first occurrence of old in text by new.
+func edit(text, old, new string) (string, ts.TextChangeRange) {
+	start := strings.Index(text, old)
+	change := ts.TextChangeRange{Start: start, Length: len(old), NewLength: len(new)}
+	return text[:start] + new + text[start+len(old):], change
+}
+
+func TestUpdate(t *testing.T) {
+	f := parseFixture(t, "sample.ts")
+	text, change := edit(f.Text(), "class Cache extends Store {", "class LRU extends Store {\n  max = 10;")
+	g, err := f.Update(text, change)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.FileName() != f.FileName() || g.ScriptKind != f.ScriptKind {
+		t.Errorf("Update: got %s (%v), want %s (%v)", g.FileName(), g.ScriptKind, f.FileName(), f.ScriptKind)
+	}
+	classes := named(g, "LRU")
+	if got := count(classes); got != 1 {
+		t.Fatalf("got %d classes named LRU, want 1", got)
+	}
+	if line, _, _ := classes.Pos(); line != 20 {
+		t.Errorf("LRU at line %d, want 20", line)
+	}
+	if line, _, _ := named(g, "handler").Pos(); line != 27 {
+		t.Errorf("handler at line %d, want 27", line)
+	}
+	if got := count(named(f, "LRU")); got != 0 {
+		t.Errorf("the original file was modified")
+	}
+}
+
+func TestUpdateUnchanged(t *testing.T) {
+	f := parseFixture(t, "sample.ts")
+	text := f.Text()
+	g, err := f.Update(text, ts.TextChangeRange{Start: 10, Length: 3, NewLength: 3})
+	if err != nil || g != f {
+		t.Errorf("Update with the same text: got %p (%v), want %p", g, err, f)
+	}
+}
+
+func TestUpdateError(t *testing.T) {
+	f := parseFixture(t, "sample.ts")
+	text, change := edit(f.Text(), "class Cache", "class Cache2")
+	change.NewLength--
+	if _, err := f.Update(text, change); err != ts.ErrInvalidChange {
+		t.Errorf("Update with a wrong change: got %v, want ErrInvalidChange", err)
+	}
+	text, change = edit(f.Text(), "get size(): number {", "get size(): number")
+	if _, err := f.Update(text, change, ts.Config{FailOnError: true}); err == nil {
+		t.Error("Update with a syntax error and FailOnError: no error")
+	}
+	if _, err := f.Update(text, change); err != nil {
+		t.Errorf("Update with a syntax error: %v", err)
+	}
+}
+
+func TestUpdateJsx(t *testing.T) {
+	f, err := ts.ParseFile("", "const a = <A />;", ts.Config{Jsx: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, change := edit(f.Text(), "<A />", "<B></B>")
+	g, err := f.Update(text, change, ts.Config{FailOnError: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := count(ts.New(&g.SourceFile).JsxElements("B")); got != 1 {
+		t.Errorf("got %d JSX elements, want 1", got)
+	}
+}
+
+func TestUpdateUndo(t *testing.T) {
+	f := parseFixture(t, "sample.ts")
+	text, change := edit(f.Text(), "class Cache", "class Cache3")
+	g, err := f.Update(text, change)
+	if err != nil {
+		t.Fatal(err)
+	}
+	undo, change := edit(g.Text(), "class Cache3", "class Cache")
+	if h, err := g.Update(undo, change); err != nil || h != f {
+		t.Errorf("Update back to the original text: got %p (%v), want %p", h, err, f)
+	}
+	if h, err := f.Update(text, ts.TextChangeRange{Start: change.Start, Length: 11, NewLength: 12}); err != nil || h != g {
+		t.Errorf("Update redone: got %p (%v), want %p", h, err, g)
+	}
+}
+
+func TestUpdateUnchangedError(t *testing.T) {
+	f, err := ts.ParseFile("", "let a = ;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.Update(f.Text(), ts.TextChangeRange{}, ts.Config{FailOnError: true}); err == nil {
+		t.Error("Update with the same text and FailOnError: no error")
+	}
+	if g, err := f.Update(f.Text(), ts.TextChangeRange{}); err != nil || g != f {
+		t.Errorf("Update with the same text: got %p (%v), want %p", g, err, f)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	src := largeSource(b, 200)
+	for b.Loop() {
+		if _, err := ts.ParseFile("large.ts", src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkUpdate makes a new edit each time, so that every Update misses the
+// cache and parses the new text in full.
+func BenchmarkUpdate(b *testing.B) {
+	f, err := ts.ParseFile("large.ts", largeSource(b, 200))
+	if err != nil {
+		b.Fatal(err)
+	}
+	i := 0
+	for b.Loop() {
+		i++
+		text, change := edit(f.Text(), "class Cache", "class Cache"+strconv.Itoa(i))
+		if _, err := f.Update(text, change); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkUpdateCached undoes and redoes the same edit, so that every Update
+// returns a cached file.
+func BenchmarkUpdateCached(b *testing.B) {
+	f, err := ts.ParseFile("large.ts", largeSource(b, 200))
+	if err != nil {
+		b.Fatal(err)
+	}
+	text, change := edit(f.Text(), "class Cache", "class Cache2")
+	g, err := f.Update(text, change)
+	if err != nil {
+		b.Fatal(err)
+	}
+	undo, change := edit(text, "class Cache2", "class Cache")
+	for b.Loop() {
+		if _, err := g.Update(undo, change); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,369 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/microsoft/typescript-go/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// childField describes a field of a syntax node holding child nodes.
+type childField struct {
+	index []int  // index of the field in the node data struct
+	name  string // uncapitalized field name, e.g. "name" or "statements"
+	list  bool   // *ast.NodeList or *ast.ModifierList
+}
+
+var (
+	tyNode         = reflect.TypeFor[*ast.Node]()
+	tyNodeList     = reflect.TypeFor[*ast.NodeList]()
+	tyModifierList = reflect.TypeOf((*ast.Node).Modifiers).Out(0)
+	tyNodeBase     = reflect.TypeFor[ast.Node]()
+
+	// index of the unexported data field of ast.Node, holding the node data
+	// struct (*ast.Identifier, *ast.SourceFile, etc.)
+	dataField = func() int {
+		f, _ := tyNodeBase.FieldByName("data")
+		return f.Index[0]
+	}()
+
+	childFields sync.Map // reflect.Type => []childField
+)
+
+// nonChildFields lists the fields of node data structs holding nodes that
+// aren't children of the node, but references set by the parser.
+var nonChildFields = map[string]bool{
+	"FullSignature":           true,
+	"CommonJSModuleIndicator": true,
+	"ExternalModuleIndicator": true,
+}
+
+// childFieldsOf returns the fields of the node data struct type t that may hold
+// child nodes, in declaration order.
+func childFieldsOf(t reflect.Type) []childField {
+	if v, ok := childFields.Load(t); ok {
+		return v.([]childField)
+	}
+	var ret []childField
+	var collect func(t reflect.Type, index []int)
+	collect = func(t reflect.Type, index []int) {
+		for i := range t.NumField() {
+			f := t.Field(i)
+			idx := append(index[:len(index):len(index)], i)
+			switch {
+			case nonChildFields[f.Name]:
+			case f.Type == tyNode:
+				ret = append(ret, childField{idx, uncapitalize(f.Name), false})
+			case f.Type == tyNodeList || f.Type == tyModifierList:
+				ret = append(ret, childField{idx, uncapitalize(f.Name), true})
+			case f.Anonymous && f.Type.Kind() == reflect.Struct:
+				// skip NodeDefault holding the node itself (with its parent link),
+				// and LocalsContainerBase holding binder data
+				if !hasField(f.Type, tyNodeBase) && f.Name != "LocalsContainerBase" {
+					collect(f.Type, idx)
+				}
+			}
+		}
+	}
+	collect(t, nil)
+	v, _ := childFields.LoadOrStore(t, ret)
+	return v.([]childField)
+}
+
+// hasField reports whether the struct type t has a field of type ft.
+func hasField(t reflect.Type, ft reflect.Type) bool {
+	for i := range t.NumField() {
+		if t.Field(i).Type == ft {
+			return true
+		}
+	}
+	return false
+}
+
+// uncapitalize returns name with its first letter lowercased, the way reflects
+// names the fields of a struct.
+func uncapitalize(name string) string {
+	if name != "" {
+		if c := name[0]; c >= 'A' && c <= 'Z' {
+			return string(c-'A'+'a') + name[1:]
+		}
+	}
+	return name
+}
+
+// -----------------------------------------------------------------------------
+
+// structField describes an exported field of a struct, the way reflects yields
+// it as a child node.
+type structField struct {
+	index int
+	name  string // uncapitalized field name
+}
+
+var structFields sync.Map // reflect.Type => []structField
+
+// structFieldsOf returns the exported fields of the struct type t, in
+// declaration order.
+func structFieldsOf(t reflect.Type) []structField {
+	if v, ok := structFields.Load(t); ok {
+		return v.([]structField)
+	}
+	var ret []structField
+	for i := range t.NumField() {
+		if f := t.Field(i); f.IsExported() {
+			ret = append(ret, structField{i, uncapitalize(f.Name)})
+		}
+	}
+	v, _ := structFields.LoadOrStore(t, ret)
+	return v.([]structField)
+}
+
+// yieldElems yields the child nodes of the given node, like reflects: the
+// exported fields of a struct, the entries of a map[string]T and the elements
+// of a slice. The fields of a struct type are looked up once, which saves the
+// per-node reflection of their names and types.
+func yieldElems(node Node, yield func(Node) bool) bool {
+	v := node.Value
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if !v.CanInterface() { // fields reached from an unexported field
+			break
+		}
+		for _, f := range structFieldsOf(v.Type()) {
+			if !yield(Node{Name: f.name, Value: v.Field(f.index)}) {
+				return false
+			}
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		for it := v.MapRange(); it.Next(); {
+			if !yield(Node{Name: it.Key().String(), Value: it.Value()}) {
+				return false
+			}
+		}
+	case reflect.Slice:
+		for i := range v.Len() {
+			if !yield(Node{Name: "", Value: v.Index(i)}) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// yieldAnyElems yields the given node and its descendants matching name, in
+// depth-first order, like reflects. If name is "", it yields all nodes.
+func yieldAnyElems(name string, node Node, yield func(Node) bool) bool {
+	if name == "" || node.Name == name {
+		if !yield(node) {
+			return false
+		}
+	}
+	return yieldElems(node, func(child Node) bool {
+		return yieldAnyElems(name, child, yield)
+	})
+}
+
+// -----------------------------------------------------------------------------
+
+// fieldNodes holds the child nodes of a field of a syntax node.
+type fieldNodes struct {
+	name  string
+	node  *ast.Node   // single node field
+	nodes []*ast.Node // list field
+	next  int         // index in nodes of the next child to visit
+}
+
+// syntaxChildren collects the child nodes of a syntax node with the names of
+// the fields holding them.
+type syntaxChildren struct {
+	fields   []fieldNodes
+	k        int // field of the last child
+	names    []string
+	children []*ast.Node
+	visit    func(child *ast.Node) bool // add, bound once to save allocations
+}
+
+var syntaxChildrenPool = sync.Pool{
+	New: func() any {
+		p := new(syntaxChildren)
+		p.visit = p.add
+		return p
+	},
+}
+
+// add adds the next child visited by ForEachChild. ForEachChild mostly visits
+// the fields in declaration order, so the field holding a child is searched
+// from the field of the previous one.
+func (p *syntaxChildren) add(child *ast.Node) bool {
+	name := ""
+	for range p.fields {
+		f := &p.fields[p.k]
+		if f.node == child {
+			name = f.name
+			break
+		}
+		if f.next < len(f.nodes) && f.nodes[f.next] == child {
+			f.next++
+			name = f.name
+			break
+		}
+		if p.k++; p.k == len(p.fields) {
+			p.k = 0
+		}
+	}
+	p.names = append(p.names, name)
+	p.children = append(p.children, child)
+	return false
+}
+
+func (p *syntaxChildren) release() {
+	clear(p.fields)
+	clear(p.children)
+	p.fields, p.k, p.names, p.children = p.fields[:0], 0, p.names[:0], p.children[:0]
+	syntaxChildrenPool.Put(p)
+}
+
+// forEachSyntaxChild calls fn for each child node of a syntax node, in the
+// order of ForEachChild, with the uncapitalized name of the field holding it.
+// It returns false if fn returns false.
+func forEachSyntaxChild(n *ast.Node, fn func(name string, child *ast.Node) bool) bool {
+	p := syntaxChildrenPool.Get().(*syntaxChildren)
+	// the data field is unexported: values reached from it can't be converted
+	// to interfaces, so the fields are read through their pointers
+	data := reflect.ValueOf(n).Elem().Field(dataField).Elem().Elem()
+	for _, f := range childFieldsOf(data.Type()) {
+		v := data.FieldByIndex(f.index)
+		if v.IsNil() {
+			continue
+		}
+		if f.list {
+			// a ModifierList starts with its embedded NodeList
+			if nodes := (*ast.NodeList)(v.UnsafePointer()).Nodes; len(nodes) > 0 {
+				p.fields = append(p.fields, fieldNodes{name: f.name, nodes: nodes})
+			}
+		} else {
+			p.fields = append(p.fields, fieldNodes{name: f.name, node: (*ast.Node)(v.UnsafePointer())})
+		}
+	}
+	n.ForEachChild(p.visit)
+	for i, child := range p.children {
+		if !fn(p.names[i], child) {
+			p.release()
+			return false
+		}
+	}
+	p.release()
+	return true
+}
+
+// yieldSyntaxChildren yields the child nodes of a syntax node, named by the
+// fields holding them (e.g. "name", "body", or "statements" for each statement
+// of a source file).
+func yieldSyntaxChildren(n *ast.Node, yield func(Node) bool) bool {
+	return forEachSyntaxChild(n, func(name string, child *ast.Node) bool {
+		return yield(Node{Name: name, Value: reflect.ValueOf(child)})
+	})
+}
+
+// yieldSyntaxAny yields the syntax node and its descendants matching name, in
+// depth-first order. If name is "", it yields all nodes.
+func yieldSyntaxAny(name string, node Node, n *ast.Node, yield func(Node) bool) bool {
+	if name == "" || node.Name == name {
+		if !yield(node) {
+			return false
+		}
+	}
+	return forEachSyntaxChild(n, func(field string, child *ast.Node) bool {
+		return yieldSyntaxAny(name, Node{Name: field, Value: reflect.ValueOf(child)}, child, yield)
+	})
+}
+
+// -----------------------------------------------------------------------------
+
+// SyntaxChild returns a NodeSet containing the child nodes of the nodes in the
+// NodeSet, like XGo_Child but with the shape of the syntax tree: the children
+// of a syntax node are its child syntax nodes, in the order ForEachChild visits
+// them, named by the fields holding them, e.g. "name", "body", or "statements"
+// for each statement of a source file. Lists are flattened, so there are no
+// "nodes" levels in between. Other values are traversed like XGo_Child.
+//
+// The fields holding child nodes are looked up once per node type, so walking
+// a syntax tree costs a single pass over the fields of each node.
+func (p NodeSet) SyntaxChild() NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			return yieldChildren(node, yield)
+		})
+	})
+}
+
+// SyntaxAny returns a NodeSet containing all descendant nodes (including the
+// nodes themselves) with the specified name, in depth-first order. Children
+// are found like SyntaxChild, e.g. SyntaxAny("parameters") returns the
+// parameters of all functions. If name is "", it returns all nodes.
+func (p NodeSet) SyntaxAny(name string) NodeSet {
+	if p.Err != nil {
+		return p
+	}
+	return NodeSet_Cast(func(yield func(Node) bool) {
+		p.Data(func(node Node) bool {
+			return yieldAny(name, node, yield)
+		})
+	})
+}
+
+// yieldChildren yields the child nodes of the given node, see SyntaxChild.
+func yieldChildren(node Node, yield func(Node) bool) bool {
+	if n := astNode(node); n != nil {
+		return yieldSyntaxChildren(n, yield)
+	}
+	return yieldElems(node, yield)
+}
+
+// yieldAny yields the given node and its descendants matching name, see
+// SyntaxAny.
+func yieldAny(name string, node Node, yield func(Node) bool) bool {
+	if n := astNode(node); n != nil {
+		return yieldSyntaxAny(name, node, n, yield)
+	}
+	if name == "" || node.Name == name {
+		if !yield(node) {
+			return false
+		}
+	}
+	return yieldChildren(node, func(child Node) bool {
+		return yieldAny(name, child, yield)
+	})
+}
+
+// -----------------------------------------------------------------------------
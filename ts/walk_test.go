@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2026 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ts_test
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/goplus/dql/ts"
+	"github.com/goplus/xgo/dql/reflects"
+	"github.com/microsoft/typescript-go/ast"
+)
+
+func TestXGoChildShape(t *testing.T) {
+	doc := ts.From("", "let a = 1;\nlet b = 2;\n")
+	// fields are reflected: statements is a NodeList holding the nodes
+	stmts := doc.XGo_Any("statements").XGo_Elem("nodes").XGo_Child()
+	if got := kindsOf(stmts); !slices.Equal(got, []ts.Kind{ts.KindVariableStatement, ts.KindVariableStatement}) {
+		t.Errorf(".**.statements.nodes.*: got %v", got)
+	}
+	var lists []string
+	for child := range doc.XGo_Child().XGo_Enum() {
+		if child.Class() == "NodeList" {
+			lists = append(lists, child.XGo_name__0())
+		}
+	}
+	if !slices.Contains(lists, "statements") {
+		t.Errorf(".*: got lists %q, want statements", lists)
+	}
+}
+
+func TestXGoAnyReflects(t *testing.T) {
+	for _, name := range []string{"sample.ts", "app.tsx", "jsdoc.ts"} {
+		f := parseFixture(t, name)
+		var want []string
+		reflects.New(reflect.ValueOf(&f.SourceFile)).XGo_Any("").Data(func(node reflects.Node) bool {
+			want = append(want, nodeKey(node))
+			return true
+		})
+		var got []string
+		ts.New(&f.SourceFile).XGo_Any("").Data(func(node ts.Node) bool {
+			got = append(got, nodeKey(node))
+			return true
+		})
+		// map entries are yielded in random order
+		slices.Sort(want)
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf(".** of %s: got %d nodes, want the %d nodes of reflects", name, len(got), len(want))
+		}
+	}
+}
+
+// nodeKey returns a string identifying the name and the value of a node.
+func nodeKey(node reflects.Node) string {
+	v := node.Value
+	var id any
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		id = v.Pointer()
+	default:
+		if v.CanAddr() {
+			id = v.Addr().Pointer()
+		} else if v.CanInterface() {
+			id = v.Interface()
+		}
+	}
+	return fmt.Sprintf("%s %v %v", node.Name, v.Type(), id)
+}
+
+func TestSyntaxChild(t *testing.T) {
+	doc := ts.From("", "export async function f<T>(a: T, b?: number): T { return a; }")
+	var got []string
+	for child := range doc.AnyKind(ts.KindFunctionDeclaration).SyntaxChild().XGo_Enum() {
+		got = append(got, child.XGo_name__0())
+	}
+	want := []string{"modifiers", "modifiers", "name", "typeParameters", "parameters", "parameters", "type", "body"}
+	if !slices.Equal(got, want) {
+		t.Errorf("SyntaxChild: got %q, want %q", got, want)
+	}
+	if got := kindsOf(doc.SyntaxChild()); !slices.Equal(got, []ts.Kind{ts.KindFunctionDeclaration, ts.KindEndOfFile}) {
+		t.Errorf("SyntaxChild of a file: got %v", got)
+	}
+	// other values are traversed by reflection
+	got = nil
+	for child := range doc.XGo_Elem("statements").SyntaxChild().XGo_Enum() {
+		got = append(got, child.XGo_name__0())
+	}
+	if want := []string{"loc", "nodes"}; !slices.Equal(got, want) {
+		t.Errorf("SyntaxChild of a NodeList: got %q, want %q", got, want)
+	}
+}
+
+func TestSyntaxChildOrder(t *testing.T) {
+	// the type is reparsed from the JSDoc comment, before the name in the
+	// source text, but ForEachChild visits it after the name
+	f, err := ts.ParseFile("a.js", "/** @type {number} */ var x = 1;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for child := range ts.New(&f.SourceFile).AnyKind(ts.KindVariableDeclaration).SyntaxChild().XGo_Enum() {
+		got = append(got, child.XGo_name__0())
+	}
+	if want := []string{"name", "type", "initializer"}; !slices.Equal(got, want) {
+		t.Errorf("SyntaxChild: got %q, want %q", got, want)
+	}
+}
+
+func TestSyntaxAny(t *testing.T) {
+	for _, name := range []string{"sample.ts", "app.tsx", "api.d.ts", "decorators.ts", "enums.ts"} {
+		f := parseFixture(t, name)
+		// all nodes, in the order of ForEachChild
+		var got, want []*ast.Node
+		ts.New(&f.SourceFile).SyntaxAny("").Data(func(node ts.Node) bool {
+			got = append(got, node.Value.Interface().(interface{ AsNode() *ast.Node }).AsNode())
+			return true
+		})
+		descendants(f).Data(func(node ts.Node) bool {
+			want = append(want, node.Value.Interface().(*ast.Node))
+			return true
+		})
+		if !slices.Equal(got, want) {
+			t.Errorf("SyntaxAny(\"\") of %s: got %d nodes, want %d", name, len(got), len(want))
+		}
+	}
+	doc := ts.New(&parseFixture(t, "sample.ts").SourceFile)
+	texts, err := doc.SyntaxAny("parameters").Texts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"path: string", "text: string", "line", "item: string"}; !slices.Equal(texts, want) {
+		t.Errorf("SyntaxAny(\"parameters\"): got %q, want %q", texts, want)
+	}
+	if got := doc.XGo_Elem("fileName").SyntaxAny("").Count(); got != 1 {
+		t.Errorf("SyntaxAny of a string: got %d nodes, want 1", got)
+	}
+}
+
+func BenchmarkXGoAny(b *testing.B) {
+	f, err := ts.ParseFile("large.ts", largeSource(b, 200))
+	if err != nil {
+		b.Fatal(err)
+	}
+	doc := ts.New(&f.SourceFile)
+	for b.Loop() {
+		doc.XGo_Any("").Count()
+	}
+}
+
+// BenchmarkReflectsXGoAny is the baseline of BenchmarkXGoAny: the same query
+// through reflects.
+func BenchmarkReflectsXGoAny(b *testing.B) {
+	f, err := ts.ParseFile("large.ts", largeSource(b, 200))
+	if err != nil {
+		b.Fatal(err)
+	}
+	doc := reflects.New(reflect.ValueOf(&f.SourceFile))
+	for b.Loop() {
+		n := 0
+		doc.XGo_Any("").Data(func(reflects.Node) bool {
+			n++
+			return true
+		})
+	}
+}
+
+func BenchmarkSyntaxAny(b *testing.B) {
+	f, err := ts.ParseFile("large.ts", largeSource(b, 200))
+	if err != nil {
+		b.Fatal(err)
+	}
+	doc := ts.New(&f.SourceFile)
+	for b.Loop() {
+		doc.SyntaxAny("").Count()
+	}
+}
+
+// BenchmarkForEachChild is the baseline of BenchmarkSyntaxAny: a plain walk of
+// the syntax tree.
+func BenchmarkForEachChild(b *testing.B) {
+	f, err := ts.ParseFile("large.ts", largeSource(b, 200))
+	if err != nil {
+		b.Fatal(err)
+	}
+	for b.Loop() {
+		n := 0
+		var walk func(node *ast.Node) bool
+		walk = func(node *ast.Node) bool {
+			n++
+			return node.ForEachChild(walk)
+		}
+		walk(f.AsNode())
+	}
+}